@@ -5,16 +5,81 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
+// ConferenceStatus is the status of a conference.
+// See https://www.twilio.com/docs/voice/api/conference-resource#conference-properties
+type ConferenceStatus string
+
+const (
+	ConferenceStatusInit       ConferenceStatus = "init"
+	ConferenceStatusInProgress ConferenceStatus = "in-progress"
+	ConferenceStatusCompleted  ConferenceStatus = "completed"
+)
+
 // Conference represents a Twilio Voice conference call
 type Conference struct {
-	Sid          string `json:"sid"`
-	FriendlyName string `json:"friendly_name"`
-	Status       string `json:"status"`
-	Region       string `json:"region"`
+	Sid                     string           `json:"sid"`
+	AccountSid              string           `json:"account_sid"`
+	FriendlyName            string           `json:"friendly_name"`
+	Status                  ConferenceStatus `json:"status"`
+	Region                  string           `json:"region"`
+	DateCreated             string           `json:"date_created"`
+	DateUpdated             string           `json:"date_updated"`
+	ReasonConferenceEnded   string           `json:"reason_conference_ended"`
+	CallSidEndingConference string           `json:"call_sid_ending_conference"`
+	ApiVersion              string           `json:"api_version"`
+	Uri                     string           `json:"uri"`
+}
+
+// DateCreatedAsTime returns Conference.DateCreated as a time.Time object
+// instead of a string.
+func (c *Conference) DateCreatedAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, c.DateCreated)
+}
+
+// DateUpdatedAsTime returns Conference.DateUpdated as a time.Time object
+// instead of a string.
+func (c *Conference) DateUpdatedAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, c.DateUpdated)
+}
+
+// ConferenceFilter narrows down the conferences returned by ListConferences.
+// Zero values are ignored.
+type ConferenceFilter struct {
+	FriendlyName      string
+	Status            ConferenceStatus
+	DateCreated       time.Time // only conferences created on this day (UTC)
+	DateCreatedBefore time.Time // only conferences created on or before this day (UTC)
+	DateCreatedAfter  time.Time // only conferences created on or after this day (UTC)
+}
+
+func (f *ConferenceFilter) values() url.Values {
+	values := url.Values{}
+	if f == nil {
+		return values
+	}
+	if f.FriendlyName != "" {
+		values.Set("FriendlyName", f.FriendlyName)
+	}
+	if f.Status != "" {
+		values.Set("Status", string(f.Status))
+	}
+	// the conference list only filters by day
+	if !f.DateCreated.IsZero() {
+		values.Set("DateCreated", f.DateCreated.UTC().Format("2006-01-02"))
+	}
+	if !f.DateCreatedBefore.IsZero() {
+		values.Set("DateCreated<", f.DateCreatedBefore.UTC().Format("2006-01-02"))
+	}
+	if !f.DateCreatedAfter.IsZero() {
+		values.Set("DateCreated>", f.DateCreatedAfter.UTC().Format("2006-01-02"))
+	}
+	return values
 }
 
 // ConferenceOptions are used for updating Conferences
@@ -103,6 +168,18 @@ func (twilio *Twilio) GetConferenceWithContext(ctx context.Context, conferenceSi
 	return conf, nil, err
 }
 
+// ListConferences returns an iterator over the conferences of the account
+// matching filter, most recent first. Pages are fetched as they are needed.
+// https://www.twilio.com/docs/voice/api/conference-resource#read-multiple-conference-resources
+func (twilio *Twilio) ListConferences(filter *ConferenceFilter, opts *PageOptions) ConferenceIterator {
+	return twilio.ListConferencesWithContext(context.Background(), filter, opts)
+}
+
+func (twilio *Twilio) ListConferencesWithContext(ctx context.Context, filter *ConferenceFilter, opts *PageOptions) ConferenceIterator {
+	twilioUrl := twilio.buildUrl("Conferences.json") + "?" + filter.values().Encode()
+	return ConferenceIterator{twilio.newIterator(ctx, twilioUrl, "conferences", opts, func() interface{} { return new(Conference) })}
+}
+
 // UpdateConference to end it or play an announcement
 // https://www.twilio.com/docs/voice/api/conference-resource#update-a-conference-resource
 func (twilio *Twilio) UpdateConference(conferenceSid string, options *ConferenceOptions) (*Conference, *Exception, error) {
@@ -164,6 +241,18 @@ func (twilio *Twilio) GetConferenceParticipantsWithContext(ctx context.Context,
 	return conf.Participants, nil, err
 }
 
+// GetConferenceParticipantsIterator returns an iterator over all participants
+// of a conference, fetching pages as they are needed.
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#read-multiple-participant-resources
+func (twilio *Twilio) GetConferenceParticipantsIterator(conferenceSid string, opts *PageOptions) ConferenceParticipantIterator {
+	return twilio.GetConferenceParticipantsIteratorWithContext(context.Background(), conferenceSid, opts)
+}
+
+func (twilio *Twilio) GetConferenceParticipantsIteratorWithContext(ctx context.Context, conferenceSid string, opts *PageOptions) ConferenceParticipantIterator {
+	twilioUrl := twilio.buildUrl(fmt.Sprintf("Conferences/%s/Participants.json", conferenceSid))
+	return ConferenceParticipantIterator{twilio.newIterator(ctx, twilioUrl, "participants", opts, func() interface{} { return new(ConferenceParticipant) })}
+}
+
 // GetConferenceParticipant fetches details for a conference participant resource
 // https://www.twilio.com/docs/voice/api/conference-participant-resource#fetch-a-participant-resource
 func (twilio *Twilio) GetConferenceParticipant(conferenceSid, callSid string) (*ConferenceParticipant, *Exception, error) {
@@ -236,6 +325,7 @@ func (twilio *Twilio) UpdateConferenceParticipantWithContext(ctx context.Context
 	if err != nil {
 		return nil, nil, err
 	}
+	defer res.Body.Close()
 
 	decoder := json.NewDecoder(res.Body)
 
@@ -260,8 +350,10 @@ func (twilio *Twilio) DeleteConferenceParticipantWithContext(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
+	// Twilio answers 204 No Content
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
 		decoder := json.NewDecoder(res.Body)
 		exception := new(Exception)
 		err = decoder.Decode(exception)
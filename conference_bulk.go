@@ -0,0 +1,136 @@
+package gotwilio
+
+import (
+	"context"
+	"sync"
+)
+
+// conferenceBulkConcurrency bounds the number of requests the bulk
+// participant operations send at once.
+const conferenceBulkConcurrency = 5
+
+// ParticipantResult is the outcome of a bulk operation for one participant
+// of a conference. Either Participant, Exception or Err is set.
+type ParticipantResult struct {
+	CallSid     string
+	Participant *ConferenceParticipant
+	Exception   *Exception
+	Err         error
+}
+
+// Failed reports whether the operation failed for the participant.
+func (r *ParticipantResult) Failed() bool {
+	return r.Exception != nil || r.Err != nil
+}
+
+// MuteAll mutes every participant of a conference. The returned error is
+// only set when the participants couldn't be listed, the outcome for each
+// participant is reported in its ParticipantResult.
+func (twilio *Twilio) MuteAll(conferenceSid string) ([]ParticipantResult, error) {
+	return twilio.MuteAllWithContext(context.Background(), conferenceSid)
+}
+
+func (twilio *Twilio) MuteAllWithContext(ctx context.Context, conferenceSid string) ([]ParticipantResult, error) {
+	return twilio.updateAllParticipants(ctx, conferenceSid, nil, &ConferenceParticipantOptions{Muted: NewBoolean(true)})
+}
+
+// UnmuteAll unmutes every participant of a conference, see MuteAll.
+func (twilio *Twilio) UnmuteAll(conferenceSid string) ([]ParticipantResult, error) {
+	return twilio.UnmuteAllWithContext(context.Background(), conferenceSid)
+}
+
+func (twilio *Twilio) UnmuteAllWithContext(ctx context.Context, conferenceSid string) ([]ParticipantResult, error) {
+	return twilio.updateAllParticipants(ctx, conferenceSid, nil, &ConferenceParticipantOptions{Muted: NewBoolean(false)})
+}
+
+// HoldAllExcept puts every participant of a conference on hold except the
+// calls in exceptCallSids, see MuteAll.
+func (twilio *Twilio) HoldAllExcept(conferenceSid string, exceptCallSids ...string) ([]ParticipantResult, error) {
+	return twilio.HoldAllExceptWithContext(context.Background(), conferenceSid, exceptCallSids...)
+}
+
+func (twilio *Twilio) HoldAllExceptWithContext(ctx context.Context, conferenceSid string, exceptCallSids ...string) ([]ParticipantResult, error) {
+	return twilio.updateAllParticipants(ctx, conferenceSid, exceptCallSids, &ConferenceParticipantOptions{Hold: NewBoolean(true)})
+}
+
+// KickAll removes every participant from a conference, see MuteAll.
+func (twilio *Twilio) KickAll(conferenceSid string) ([]ParticipantResult, error) {
+	return twilio.KickAllWithContext(context.Background(), conferenceSid)
+}
+
+func (twilio *Twilio) KickAllWithContext(ctx context.Context, conferenceSid string) ([]ParticipantResult, error) {
+	return twilio.forEachParticipant(ctx, conferenceSid, nil, func(ctx context.Context, callSid string) (*ConferenceParticipant, *Exception, error) {
+		exception, err := twilio.DeleteConferenceParticipantWithContext(ctx, conferenceSid, callSid)
+		return nil, exception, err
+	})
+}
+
+// StartCoaching makes coachCallSid coach agentCallSid: the coach hears the
+// whole conference but only the agent hears the coach.
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#update-a-participant-resource
+func (twilio *Twilio) StartCoaching(conferenceSid, coachCallSid, agentCallSid string) (*ConferenceParticipant, *Exception, error) {
+	return twilio.StartCoachingWithContext(context.Background(), conferenceSid, coachCallSid, agentCallSid)
+}
+
+func (twilio *Twilio) StartCoachingWithContext(ctx context.Context, conferenceSid, coachCallSid, agentCallSid string) (*ConferenceParticipant, *Exception, error) {
+	return twilio.UpdateConferenceParticipantWithContext(ctx, conferenceSid, coachCallSid, &ConferenceParticipantOptions{
+		Coaching:       NewBoolean(true),
+		CallSidToCoach: agentCallSid,
+	})
+}
+
+// StopCoaching lets the whole conference hear coachCallSid, e.g. to barge in.
+func (twilio *Twilio) StopCoaching(conferenceSid, coachCallSid string) (*ConferenceParticipant, *Exception, error) {
+	return twilio.StopCoachingWithContext(context.Background(), conferenceSid, coachCallSid)
+}
+
+func (twilio *Twilio) StopCoachingWithContext(ctx context.Context, conferenceSid, coachCallSid string) (*ConferenceParticipant, *Exception, error) {
+	return twilio.UpdateConferenceParticipantWithContext(ctx, conferenceSid, coachCallSid, &ConferenceParticipantOptions{
+		Coaching: NewBoolean(false),
+	})
+}
+
+func (twilio *Twilio) updateAllParticipants(ctx context.Context, conferenceSid string, exceptCallSids []string, options *ConferenceParticipantOptions) ([]ParticipantResult, error) {
+	return twilio.forEachParticipant(ctx, conferenceSid, exceptCallSids, func(ctx context.Context, callSid string) (*ConferenceParticipant, *Exception, error) {
+		return twilio.UpdateConferenceParticipantWithContext(ctx, conferenceSid, callSid, options)
+	})
+}
+
+// forEachParticipant calls fn for every participant of a conference, except
+// the calls in exceptCallSids, with at most conferenceBulkConcurrency calls
+// running at once. The results are in the order the participants were listed.
+func (twilio *Twilio) forEachParticipant(ctx context.Context, conferenceSid string, exceptCallSids []string, fn func(ctx context.Context, callSid string) (*ConferenceParticipant, *Exception, error)) ([]ParticipantResult, error) {
+	except := make(map[string]bool, len(exceptCallSids))
+	for _, callSid := range exceptCallSids {
+		except[callSid] = true
+	}
+
+	var callSids []string
+	it := twilio.GetConferenceParticipantsIteratorWithContext(ctx, conferenceSid, nil)
+	for it.Next() {
+		if callSid := it.Value().CallSid; !except[callSid] {
+			callSids = append(callSids, callSid)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]ParticipantResult, len(callSids))
+	sem := make(chan struct{}, conferenceBulkConcurrency)
+	var wg sync.WaitGroup
+	for i, callSid := range callSids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *ParticipantResult, callSid string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result.CallSid = callSid
+			result.Participant, result.Exception, result.Err = fn(ctx, callSid)
+		}(&results[i], callSid)
+	}
+	wg.Wait()
+
+	return results, nil
+}
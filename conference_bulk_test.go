@@ -0,0 +1,103 @@
+package gotwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHoldAllExcept(t *testing.T) {
+	var running, maxRunning int32
+	var mu sync.Mutex
+	held := map[string]string{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			var participants []string
+			for i := 1; i <= 12; i++ {
+				participants = append(participants, fmt.Sprintf(`{"call_sid": "CA%d", "conference_sid": "CF1"}`, i))
+			}
+			fmt.Fprintf(w, `{"participants": [%s], "next_page_uri": null}`, strings.Join(participants, ","))
+			return
+		}
+
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		r.ParseForm()
+		callSid := strings.TrimSuffix(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], ".json")
+		mu.Lock()
+		held[callSid] = r.PostForm.Get("Hold")
+		mu.Unlock()
+
+		if callSid == "CA7" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code": 20404, "message": "not found", "status": 404}`)
+			return
+		}
+		fmt.Fprintf(w, `{"call_sid": %q, "conference_sid": "CF1", "hold": true}`, callSid)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	results, err := twilio.HoldAllExcept("CF1", "CA1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 11 {
+		t.Fatalf("expected 11 results, got %d", len(results))
+	}
+	if _, ok := held["CA1"]; ok {
+		t.Error("excepted participant was put on hold")
+	}
+	for _, result := range results {
+		if held[result.CallSid] != "true" {
+			t.Errorf("%s was not put on hold", result.CallSid)
+		}
+		if failed := result.Failed(); failed != (result.CallSid == "CA7") {
+			t.Errorf("%s: unexpected result %+v", result.CallSid, result)
+		}
+	}
+	if maxRunning > conferenceBulkConcurrency {
+		t.Errorf("expected at most %d concurrent requests, got %d", conferenceBulkConcurrency, maxRunning)
+	}
+}
+
+func TestStartCoaching(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/Accounts/AC1/Conferences/CF1/Participants/CA2.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.PostForm.Get("Coaching") != "true" || r.PostForm.Get("CallSidToCoach") != "CA1" {
+			t.Errorf("Unexpected parameters: %v", r.PostForm)
+		}
+		fmt.Fprint(w, `{"call_sid": "CA2", "coaching": true, "call_sid_to_coach": "CA1"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	p, exc, err := twilio.StartCoaching("CF1", "CA2", "CA1")
+	if err != nil || exc != nil {
+		t.Fatalf("Unexpected failure: %v %v", exc, err)
+	}
+	if !p.Coaching || p.CallSidToCoach != "CA1" {
+		t.Errorf("Unexpected participant: %+v", p)
+	}
+}
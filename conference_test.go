@@ -1,8 +1,12 @@
 package gotwilio
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -80,3 +84,49 @@ func TestTwilio_Conference(t *testing.T) {
 	validateTwilioException(t, exception)
 	assert.NoError(t, err)
 }
+
+func TestListConferencesAndRecordings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/Accounts/AC1/Conferences.json":
+			q := r.URL.Query()
+			assert.Equal(t, "in-progress", q.Get("Status"))
+			assert.Equal(t, "2020-01-01", q.Get("DateCreated>"))
+			fmt.Fprint(w, `{"conferences": [{"sid": "CF1", "status": "in-progress", "reason_conference_ended": null}], "next_page_uri": null}`)
+		case "/Accounts/AC1/Conferences/CF1/Recordings.json":
+			fmt.Fprint(w, `{"recordings": [{"sid": "RE1", "conference_sid": "CF1", "status": "in-progress"}], "next_page_uri": null}`)
+		case "/Accounts/AC1/Conferences/CF1/Recordings/RE1.json":
+			r.ParseForm()
+			assert.Equal(t, "stopped", r.PostForm.Get("Status"))
+			fmt.Fprint(w, `{"sid": "RE1", "conference_sid": "CF1", "status": "stopped"}`)
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewTwilioClient("AC1", "")
+	client.BaseUrl = srv.URL
+
+	it := client.ListConferences(&ConferenceFilter{
+		Status:           ConferenceStatusInProgress,
+		DateCreatedAfter: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+	}, nil)
+	var conferences []*Conference
+	for it.Next() {
+		conferences = append(conferences, it.Value())
+	}
+	assert.NoError(t, it.Err())
+	assert.Len(t, conferences, 1)
+
+	recordings := client.ListConferenceRecordings(conferences[0].Sid, nil)
+	assert.True(t, recordings.Next())
+	assert.Equal(t, "RE1", recordings.Value().Sid)
+	assert.False(t, recordings.Next())
+	assert.NoError(t, recordings.Err())
+
+	rec, exception, err := client.UpdateConferenceRecording("CF1", "RE1", RecordingStatusStopped, "")
+	assert.NoError(t, err)
+	assert.Nil(t, exception)
+	assert.Equal(t, RecordingStatusStopped, rec.Status)
+}
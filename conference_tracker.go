@@ -0,0 +1,219 @@
+package gotwilio
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// conferenceEndedRetention is how long ConferenceTracker remembers ended
+// conferences to drop their late events.
+const conferenceEndedRetention = time.Hour
+
+// ConferenceState is a snapshot of a conference kept by ConferenceTracker.
+type ConferenceState struct {
+	ConferenceSid string
+	FriendlyName  string
+	Started       bool
+
+	// Participants currently in the conference, by CallSid
+	Participants map[string]ConferenceParticipantState
+}
+
+// ConferenceParticipantState is the state of a participant kept by
+// ConferenceTracker.
+type ConferenceParticipantState struct {
+	CallSid          string
+	ParticipantLabel string
+	Muted            bool
+	Hold             bool
+	Speaking         bool
+	Coaching         bool
+	CallSidToCoach   string
+}
+
+// ConferenceTracker maintains the state of conferences in memory from their
+// ConferenceStatusWebhook events, so it can be queried without polling
+// GetConferenceParticipants. Subscribe to the join, leave, mute, hold,
+// modify and speaker events for complete participant state.
+//
+// Twilio doesn't guarantee that callbacks arrive in order: events older than
+// the last one applied to a participant, going by SequenceNumber, are
+// ignored. A conference is forgotten once its conference-end event arrives,
+// events arriving after it are ignored.
+//
+// A ConferenceTracker is safe for concurrent use.
+type ConferenceTracker struct {
+	mu          sync.RWMutex
+	conferences map[string]*trackedConference
+
+	// ended holds when the conferences that have ended were forgotten.
+	ended map[string]time.Time
+}
+
+type trackedConference struct {
+	state ConferenceState
+
+	// sequences holds the SequenceNumber of the last event applied to each
+	// participant, including the ones that have left.
+	sequences map[string]int
+}
+
+// NewConferenceTracker returns an empty ConferenceTracker.
+func NewConferenceTracker() *ConferenceTracker {
+	return &ConferenceTracker{
+		conferences: make(map[string]*trackedConference),
+		ended:       make(map[string]time.Time),
+	}
+}
+
+// Update applies a conference status callback to the tracked state.
+func (t *ConferenceTracker) Update(hook *ConferenceStatusWebhook) {
+	if hook.ConferenceSid == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.conferences == nil {
+		t.conferences = make(map[string]*trackedConference)
+	}
+	if _, ok := t.ended[hook.ConferenceSid]; ok {
+		return
+	}
+
+	if hook.StatusCallbackEvent == ConferenceEventEnd {
+		t.end(hook.ConferenceSid)
+		return
+	}
+
+	conf, ok := t.conferences[hook.ConferenceSid]
+	if !ok && hook.StatusCallbackEvent == ConferenceEventParticipantLeave {
+		// a leave event of a conference that ended before it was tracked
+		return
+	}
+	if !ok {
+		conf = &trackedConference{
+			state: ConferenceState{
+				ConferenceSid: hook.ConferenceSid,
+				Participants:  make(map[string]ConferenceParticipantState),
+			},
+			sequences: make(map[string]int),
+		}
+		t.conferences[hook.ConferenceSid] = conf
+	}
+	if hook.FriendlyName != "" {
+		conf.state.FriendlyName = hook.FriendlyName
+	}
+
+	if hook.StatusCallbackEvent == ConferenceEventStart {
+		conf.state.Started = true
+		return
+	}
+	if hook.CallSid == "" {
+		return
+	}
+
+	if last, ok := conf.sequences[hook.CallSid]; ok && hook.SequenceNumber != 0 && hook.SequenceNumber < last {
+		return
+	}
+	conf.sequences[hook.CallSid] = hook.SequenceNumber
+
+	participant := conf.state.Participants[hook.CallSid]
+	switch hook.StatusCallbackEvent {
+	case ConferenceEventParticipantJoin, ConferenceEventParticipantModify:
+		participant = ConferenceParticipantState{
+			CallSid:          hook.CallSid,
+			ParticipantLabel: hook.ParticipantLabel,
+			Muted:            hook.Muted,
+			Hold:             hook.Hold,
+			Speaking:         participant.Speaking,
+			Coaching:         hook.Coaching,
+			CallSidToCoach:   hook.CallSidToCoach,
+		}
+		// participants only appear in the conference once they join
+		conf.state.Started = true
+	case ConferenceEventParticipantLeave:
+		delete(conf.state.Participants, hook.CallSid)
+		return
+	case ConferenceEventParticipantMute, ConferenceEventParticipantUnmute:
+		participant.Muted = hook.StatusCallbackEvent == ConferenceEventParticipantMute
+	case ConferenceEventParticipantHold, ConferenceEventParticipantUnhold:
+		participant.Hold = hook.StatusCallbackEvent == ConferenceEventParticipantHold
+	case ConferenceEventSpeechStart, ConferenceEventSpeechStop:
+		participant.Speaking = hook.StatusCallbackEvent == ConferenceEventSpeechStart
+	default:
+		return
+	}
+
+	// the join event may be late or missed, track what we know
+	participant.CallSid = hook.CallSid
+	if hook.ParticipantLabel != "" {
+		participant.ParticipantLabel = hook.ParticipantLabel
+	}
+	conf.state.Participants[hook.CallSid] = participant
+}
+
+// Conference returns a snapshot of the state of a conference, or false if
+// the conference isn't tracked.
+func (t *ConferenceTracker) Conference(conferenceSid string) (ConferenceState, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	conf, ok := t.conferences[conferenceSid]
+	if !ok {
+		return ConferenceState{}, false
+	}
+	return conf.snapshot(), true
+}
+
+// Conferences returns a snapshot of all the tracked conferences, sorted by
+// ConferenceSid.
+func (t *ConferenceTracker) Conferences() []ConferenceState {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	states := make([]ConferenceState, 0, len(t.conferences))
+	for _, conf := range t.conferences {
+		states = append(states, conf.snapshot())
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ConferenceSid < states[j].ConferenceSid
+	})
+	return states
+}
+
+// Forget stops tracking a conference, e.g. when its conference-end event was
+// missed. Its later events are ignored.
+func (t *ConferenceTracker) Forget(conferenceSid string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.end(conferenceSid)
+}
+
+// end forgets a conference and remembers that it has ended for
+// conferenceEndedRetention. t.mu must be held.
+func (t *ConferenceTracker) end(conferenceSid string) {
+	delete(t.conferences, conferenceSid)
+
+	now := time.Now()
+	if t.ended == nil {
+		t.ended = make(map[string]time.Time)
+	}
+	for sid, ended := range t.ended {
+		if now.Sub(ended) > conferenceEndedRetention {
+			delete(t.ended, sid)
+		}
+	}
+	t.ended[conferenceSid] = now
+}
+
+func (c *trackedConference) snapshot() ConferenceState {
+	state := c.state
+	state.Participants = make(map[string]ConferenceParticipantState, len(c.state.Participants))
+	for sid, participant := range c.state.Participants {
+		state.Participants[sid] = participant
+	}
+	return state
+}
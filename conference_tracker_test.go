@@ -0,0 +1,70 @@
+package gotwilio
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestConferenceTracker(t *testing.T) {
+	tracker := NewConferenceTracker()
+
+	events := []url.Values{
+		{"ConferenceSid": {"CF1"}, "FriendlyName": {"support"}, "StatusCallbackEvent": {"conference-start"}, "SequenceNumber": {"1"}},
+		{"ConferenceSid": {"CF1"}, "StatusCallbackEvent": {"participant-join"}, "CallSid": {"CA1"}, "ParticipantLabel": {"customer"}, "Muted": {"false"}, "Hold": {"false"}, "SequenceNumber": {"2"}},
+		{"ConferenceSid": {"CF1"}, "StatusCallbackEvent": {"participant-join"}, "CallSid": {"CA2"}, "Muted": {"false"}, "SequenceNumber": {"3"}},
+		{"ConferenceSid": {"CF1"}, "StatusCallbackEvent": {"participant-hold"}, "CallSid": {"CA1"}, "SequenceNumber": {"5"}},
+		// delivered out of order, must not override the hold
+		{"ConferenceSid": {"CF1"}, "StatusCallbackEvent": {"participant-unhold"}, "CallSid": {"CA1"}, "SequenceNumber": {"4"}},
+		{"ConferenceSid": {"CF1"}, "StatusCallbackEvent": {"participant-mute"}, "CallSid": {"CA2"}, "SequenceNumber": {"6"}},
+		{"ConferenceSid": {"CF1"}, "StatusCallbackEvent": {"participant-speech-start"}, "CallSid": {"CA1"}, "SequenceNumber": {"7"}},
+	}
+	for _, event := range events {
+		hook := new(ConferenceStatusWebhook)
+		if err := DecodeWebhook(event, hook); err != nil {
+			t.Fatal(err)
+		}
+		tracker.Update(hook)
+	}
+
+	conf, ok := tracker.Conference("CF1")
+	if !ok {
+		t.Fatal("conference is not tracked")
+	}
+	if !conf.Started || conf.FriendlyName != "support" || len(conf.Participants) != 2 {
+		t.Fatalf("unexpected conference: %+v", conf)
+	}
+	if customer := conf.Participants["CA1"]; !customer.Hold || !customer.Speaking || customer.ParticipantLabel != "customer" {
+		t.Errorf("unexpected customer state: %+v", customer)
+	}
+	if agent := conf.Participants["CA2"]; !agent.Muted || agent.Hold {
+		t.Errorf("unexpected agent state: %+v", agent)
+	}
+
+	// snapshots are copies
+	delete(conf.Participants, "CA1")
+	if conf, _ = tracker.Conference("CF1"); len(conf.Participants) != 2 {
+		t.Error("snapshot shares state with the tracker")
+	}
+
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF1", StatusCallbackEvent: ConferenceEventParticipantLeave, CallSid: "CA2", SequenceNumber: 8})
+	// a stale join must not bring the participant back
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF1", StatusCallbackEvent: ConferenceEventParticipantJoin, CallSid: "CA2", SequenceNumber: 3})
+	if conf, _ = tracker.Conference("CF1"); len(conf.Participants) != 1 {
+		t.Errorf("unexpected participants after leave: %+v", conf.Participants)
+	}
+
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF1", StatusCallbackEvent: ConferenceEventEnd, SequenceNumber: 9})
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF1", StatusCallbackEvent: ConferenceEventParticipantLeave, CallSid: "CA1", SequenceNumber: 10})
+	if len(tracker.Conferences()) != 0 {
+		t.Errorf("ended conference is still tracked: %+v", tracker.Conferences())
+	}
+
+	// late events of an ended conference must not bring it back
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF2", StatusCallbackEvent: ConferenceEventParticipantJoin, CallSid: "CA1", SequenceNumber: 2})
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF2", StatusCallbackEvent: ConferenceEventEnd, SequenceNumber: 5})
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF2", StatusCallbackEvent: ConferenceEventSpeechStop, CallSid: "CA1", SequenceNumber: 4})
+	tracker.Update(&ConferenceStatusWebhook{ConferenceSid: "CF2", StatusCallbackEvent: ConferenceEventParticipantMute, CallSid: "CA1", SequenceNumber: 6})
+	if len(tracker.Conferences()) != 0 {
+		t.Errorf("ended conference was tracked again: %+v", tracker.Conferences())
+	}
+}
@@ -1,3 +1,17 @@
 package gotwilio
 
 type ExceptionCode int
+
+const (
+	// ErrorResourceNotFound is returned when the requested resource does not
+	// exist, e.g. when fetching, updating or deleting an unknown Sid.
+	ErrorResourceNotFound ExceptionCode = 20404
+
+	// ErrorTooManyRequests is returned when the account has exceeded Twilio's
+	// concurrency or rate limits.
+	ErrorTooManyRequests ExceptionCode = 20429
+
+	// ErrorInvalidCallState is returned when a call can't be updated because
+	// of its current status, e.g. when redirecting a call that has ended.
+	ErrorInvalidCallState ExceptionCode = 21220
+)
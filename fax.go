@@ -3,9 +3,14 @@ package gotwilio
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -56,12 +61,21 @@ type FaxResourcesList struct {
 	Faxes []*FaxResource `json:"faxes"`
 }
 
+// faxUrl returns the base URL of the fax API, falling back to the default
+// for clients that weren't created with NewTwilioClient.
+func (t *Twilio) faxUrl() string {
+	if t.FaxUrl == "" {
+		return faxURL
+	}
+	return t.FaxUrl
+}
+
 func (t *Twilio) CancelFax(faxSid string) (*Exception, error) {
 	return t.CancelFaxWithContext(context.Background(), faxSid)
 }
 
 func (t *Twilio) CancelFaxWithContext(ctx context.Context, faxSid string) (*Exception, error) {
-	resp, err := t.post(ctx, url.Values{"Status": []string{"cancelled"}}, "https://fax.twilio.com/v1/Faxes/"+faxSid)
+	resp, err := t.post(ctx, url.Values{"Status": []string{"cancelled"}}, t.faxUrl()+"/Faxes/"+faxSid)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +96,7 @@ func (t *Twilio) DeleteFax(faxSid string) (*Exception, error) {
 }
 
 func (t *Twilio) DeleteFaxWithContext(ctx context.Context, faxSid string) (*Exception, error) {
-	resp, err := t.delete(ctx, "https://fax.twilio.com/v1/Faxes/" + faxSid)
+	resp, err := t.delete(ctx, t.faxUrl() + "/Faxes/" + faxSid)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +117,7 @@ func (t *Twilio) GetFax(faxSid string) (*FaxResource, *Exception, error) {
 }
 
 func (t *Twilio) GetFaxWithContext(ctx context.Context, faxSid string) (*FaxResource, *Exception, error) {
-	resp, err := t.get(ctx, "https://fax.twilio.com/v1/Faxes/" + faxSid)
+	resp, err := t.get(ctx, t.faxUrl() + "/Faxes/" + faxSid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,73 +147,152 @@ func (t *Twilio) GetFaxes(to, from, createdOnOrBefore, createdAfter string) ([]*
 }
 
 func (t *Twilio) GetFaxesWithContext(ctx context.Context, to, from, createdOnOrBefore, createdAfter string) ([]*FaxResource, *Exception, error) {
-	values := url.Values{}
-	if to != "" {
-		values.Set("To", to)
+	var frs []*FaxResource
+	it := t.GetFaxesIteratorWithContext(ctx, to, from, createdOnOrBefore, createdAfter, nil)
+	for it.Next() {
+		frs = append(frs, it.Value())
 	}
-	if from != "" {
-		values.Set("From", from)
+	if err := it.Err(); err != nil {
+		if exc, ok := err.(*Exception); ok {
+			return nil, exc, nil
+		}
+		return nil, nil, err
 	}
-	if createdOnOrBefore != "" {
-		values.Set("DateCreatedOnOrBefore", createdOnOrBefore)
+	return frs, nil, nil
+}
+
+// GetFaxesIterator returns an iterator over the faxes for a Twilio account,
+// fetching pages as they are needed.
+// See https://www.twilio.com/docs/fax/api/faxes#fax-list-resource
+func (t *Twilio) GetFaxesIterator(to, from, createdOnOrBefore, createdAfter string, opts *PageOptions) FaxIterator {
+	return t.GetFaxesIteratorWithContext(context.Background(), to, from, createdOnOrBefore, createdAfter, opts)
+}
+
+func (t *Twilio) GetFaxesIteratorWithContext(ctx context.Context, to, from, createdOnOrBefore, createdAfter string, opts *PageOptions) FaxIterator {
+	values := faxFilterValues(to, from, createdOnOrBefore, createdAfter)
+
+	twilioUrl := t.faxUrl() + "/Faxes?" + values.Encode()
+	return FaxIterator{t.newIterator(ctx, twilioUrl, "faxes", opts, func() interface{} { return new(FaxResource) })}
+}
+
+// FaxQuality is the quality a fax is sent with.
+type FaxQuality string
+
+const (
+	FaxQualityStandard  FaxQuality = "standard"
+	FaxQualityFine      FaxQuality = "fine"
+	FaxQualitySuperfine FaxQuality = "superfine"
+)
+
+// SendFaxRequest describes an outgoing fax.
+// See https://www.twilio.com/docs/fax/api/fax-resource#create-a-fax-resource
+type SendFaxRequest struct {
+	To       string // Required, a phone number or a SIP URI
+	From     string // Required
+	MediaUrl string // Required
+
+	Quality         FaxQuality // Optional, Twilio defaults to fine
+	StatusCallback  string     // Optional
+	SipAuthUsername string     // Optional, only for SIP URIs in To
+	SipAuthPassword string     // Optional, only for SIP URIs in To
+
+	// StoreMedia controls whether Twilio keeps a copy of the fax media,
+	// nil leaves Twilio's default (true).
+	StoreMedia *bool
+
+	// Ttl is the number of minutes Twilio keeps trying to send the fax,
+	// 0 leaves Twilio's default.
+	Ttl int
+}
+
+// Validate checks the request for missing or conflicting parameters before
+// it is sent.
+func (r *SendFaxRequest) Validate() error {
+	if r.To == "" || r.From == "" || r.MediaUrl == "" {
+		return errors.New("To, From and MediaUrl are required")
 	}
-	if createdAfter != "" {
-		values.Set("DateCreatedAfter", createdAfter)
+	switch r.Quality {
+	case "", FaxQualityStandard, FaxQualityFine, FaxQualitySuperfine:
+	default:
+		return fmt.Errorf("unsupported Quality %q", r.Quality)
+	}
+	if (r.SipAuthUsername != "" || r.SipAuthPassword != "") && !strings.HasPrefix(strings.ToLower(r.To), "sip:") {
+		return errors.New("SipAuthUsername and SipAuthPassword require a SIP URI in To")
 	}
+	if r.Ttl < 0 {
+		return errors.New("Ttl can't be negative")
+	}
+	return nil
+}
 
-	resp, err := t.get(ctx, "https://fax.twilio.com/v1/Faxes")
-	if err != nil {
-		return nil, nil, err
+func (r *SendFaxRequest) formValues() url.Values {
+	values := url.Values{}
+	values.Set("To", r.To)
+	values.Set("From", r.From)
+	values.Set("MediaUrl", r.MediaUrl)
+	if r.Quality != "" {
+		values.Set("Quality", string(r.Quality))
 	}
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, nil, err
+	if r.StatusCallback != "" {
+		values.Set("StatusCallback", r.StatusCallback)
 	}
-	if resp.StatusCode != http.StatusOK {
-		exc := new(Exception)
-		err = json.Unmarshal(respBody, exc)
-		return nil, exc, err
+	if r.SipAuthUsername != "" {
+		values.Set("SipAuthUsername", r.SipAuthUsername)
 	}
-
-	lr := t.newListResources(ctx)
-	if err := json.Unmarshal(respBody, lr); err != nil {
-		return nil, nil, err
+	if r.SipAuthPassword != "" {
+		values.Set("SipAuthPassword", r.SipAuthPassword)
 	}
-	frs := lr.Faxes
-	for lr.hasNext() {
-		if exc, err := lr.next(); exc != nil || err != nil {
-			return nil, exc, err
-		}
-		frs = append(frs, lr.Faxes...)
+	if r.StoreMedia != nil {
+		values.Set("StoreMedia", strconv.FormatBool(*r.StoreMedia))
 	}
-	return frs, nil, nil
+	if r.Ttl != 0 {
+		values.Set("Ttl", strconv.Itoa(r.Ttl))
+	}
+	return values
 }
 
 // SendFax uses Twilio to send a fax.
 // See https://www.twilio.com/docs/fax/api/faxes#list-post for more information.
+// Use CreateFax for the parameters SendFax doesn't support.
 func (t *Twilio) SendFax(to, from, mediaUrl, quality, statusCallback string, storeMedia bool) (*FaxResource, *Exception, error) {
 	return t.SendFaxWithContext(context.Background(), to, from, mediaUrl, quality, statusCallback, storeMedia)
 }
 
 func (t *Twilio) SendFaxWithContext(ctx context.Context, to, from, mediaUrl, quality, statusCallback string, storeMedia bool) (*FaxResource, *Exception, error) {
-	values := url.Values{}
-	values.Set("To", to)
-	values.Set("From", from)
-	values.Set("MediaUrl", mediaUrl)
-	if quality != "" {
-		values.Set("Quality", quality)
-	}
-	if statusCallback != "" {
-		values.Set("StatusCallback", statusCallback)
+	req := &SendFaxRequest{
+		To:             to,
+		From:           from,
+		MediaUrl:       mediaUrl,
+		Quality:        FaxQuality(quality),
+		StatusCallback: statusCallback,
 	}
 	if storeMedia {
-		values.Set("StoreMedia", "true")
+		req.StoreMedia = NewBoolean(true)
 	}
+	// SendFax passes its arguments through to Twilio unchecked, as it always has
+	return t.createFax(ctx, req)
+}
+
+// CreateFax uses Twilio to send a fax described by req.
+// See https://www.twilio.com/docs/fax/api/fax-resource#create-a-fax-resource
+func (t *Twilio) CreateFax(req *SendFaxRequest) (*FaxResource, *Exception, error) {
+	return t.CreateFaxWithContext(context.Background(), req)
+}
 
-	resp, err := t.post(ctx, values, "https://fax.twilio.com/v1/Faxes")
+func (t *Twilio) CreateFaxWithContext(ctx context.Context, req *SendFaxRequest) (*FaxResource, *Exception, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return t.createFax(ctx, req)
+}
+
+func (t *Twilio) createFax(ctx context.Context, req *SendFaxRequest) (*FaxResource, *Exception, error) {
+	resp, err := t.post(ctx, req.formValues(), t.faxUrl()+"/Faxes")
 	if err != nil {
 		return nil, nil, err
 	}
+	defer resp.Body.Close()
+
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, nil, err
@@ -216,3 +309,76 @@ func (t *Twilio) SendFaxWithContext(ctx context.Context, to, from, mediaUrl, qua
 	}
 	return fr, nil, nil
 }
+
+// ListFaxMedia returns an iterator over the media of a fax. Twilio only
+// keeps the media of faxes sent with StoreMedia.
+// See https://www.twilio.com/docs/fax/api/fax-media-resource#read-multiple-faxmedia-resources
+func (t *Twilio) ListFaxMedia(faxSid string, opts *PageOptions) FaxMediaIterator {
+	return t.ListFaxMediaWithContext(context.Background(), faxSid, opts)
+}
+
+func (t *Twilio) ListFaxMediaWithContext(ctx context.Context, faxSid string, opts *PageOptions) FaxMediaIterator {
+	twilioUrl := t.faxUrl() + "/Faxes/" + faxSid + "/Media"
+	return FaxMediaIterator{t.newIterator(ctx, twilioUrl, "media", opts, func() interface{} { return new(FaxMediaResource) })}
+}
+
+// DownloadFaxMedia writes the content of a fax media file to w and returns
+// its content type, e.g. application/pdf. The FaxMedia resource itself only
+// describes the file, so the content is fetched from the media_url of the
+// fax, which must be the given media.
+// See https://www.twilio.com/docs/fax/api/fax-resource#fax-properties
+func (t *Twilio) DownloadFaxMedia(faxSid, mediaSid string, w io.Writer) (string, *Exception, error) {
+	return t.DownloadFaxMediaWithContext(context.Background(), faxSid, mediaSid, w)
+}
+
+func (t *Twilio) DownloadFaxMediaWithContext(ctx context.Context, faxSid, mediaSid string, w io.Writer) (string, *Exception, error) {
+	fr, exc, err := t.GetFaxWithContext(ctx, faxSid)
+	if err != nil || exc != nil {
+		return "", exc, err
+	}
+	if fr.MediaSid != mediaSid || fr.MediaUrl == "" {
+		return "", nil, fmt.Errorf("fax %s has no media %s", faxSid, mediaSid)
+	}
+	if !t.isTwilioURL(fr.MediaUrl) {
+		return "", nil, fmt.Errorf("refusing to send credentials to %q, not a Twilio URL", fr.MediaUrl)
+	}
+	return t.download(ctx, fr.MediaUrl, w)
+}
+
+// DeleteFaxMedia deletes a fax media file from Twilio.
+// See https://www.twilio.com/docs/fax/api/fax-media-resource#delete-a-faxmedia-resource
+func (t *Twilio) DeleteFaxMedia(faxSid, mediaSid string) (*Exception, error) {
+	return t.DeleteFaxMediaWithContext(context.Background(), faxSid, mediaSid)
+}
+
+func (t *Twilio) DeleteFaxMediaWithContext(ctx context.Context, faxSid, mediaSid string) (*Exception, error) {
+	resp, err := t.delete(ctx, t.faxUrl()+"/Faxes/"+faxSid+"/Media/"+mediaSid)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		exc := new(Exception)
+		err = json.NewDecoder(resp.Body).Decode(exc)
+		return exc, err
+	}
+	return nil, nil
+}
+
+func faxFilterValues(to, from, createdOnOrBefore, createdAfter string) url.Values {
+	values := url.Values{}
+	if to != "" {
+		values.Set("To", to)
+	}
+	if from != "" {
+		values.Set("From", from)
+	}
+	if createdOnOrBefore != "" {
+		values.Set("DateCreatedOnOrBefore", createdOnOrBefore)
+	}
+	if createdAfter != "" {
+		values.Set("DateCreatedAfter", createdAfter)
+	}
+	return values
+}
@@ -0,0 +1,197 @@
+package gotwilio
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSendFaxRequestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   SendFaxRequest
+		valid bool
+	}{
+		{"minimal", SendFaxRequest{To: "+19135551234", From: "+15005550006", MediaUrl: "https://example.com/fax.pdf"}, true},
+		{"no media", SendFaxRequest{To: "+19135551234", From: "+15005550006"}, false},
+		{"quality", SendFaxRequest{To: "+19135551234", From: "+15005550006", MediaUrl: "https://example.com/fax.pdf", Quality: FaxQualitySuperfine}, true},
+		{"invalid quality", SendFaxRequest{To: "+19135551234", From: "+15005550006", MediaUrl: "https://example.com/fax.pdf", Quality: "best"}, false},
+		{"sip auth without sip", SendFaxRequest{To: "+19135551234", From: "+15005550006", MediaUrl: "https://example.com/fax.pdf", SipAuthUsername: "alice"}, false},
+		{"sip auth", SendFaxRequest{To: "sip:fax@example.com", From: "+15005550006", MediaUrl: "https://example.com/fax.pdf", SipAuthUsername: "alice"}, true},
+		{"negative ttl", SendFaxRequest{To: "+19135551234", From: "+15005550006", MediaUrl: "https://example.com/fax.pdf", Ttl: -1}, false},
+	}
+	for _, test := range tests {
+		err := test.req.Validate()
+		if (err == nil) != test.valid {
+			t.Errorf("%s: expected valid=%t, got %v", test.name, test.valid, err)
+		}
+	}
+}
+
+func TestFaxUrlDefault(t *testing.T) {
+	twilio := &Twilio{AccountSid: "AC1"}
+	if got := twilio.faxUrl(); got != "https://fax.twilio.com/v1" {
+		t.Errorf("Unexpected fax URL: %s", got)
+	}
+}
+
+func TestCreateFax(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/Faxes" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.PostForm.Get("Quality") != "superfine" || r.PostForm.Get("StoreMedia") != "false" || r.PostForm.Get("Ttl") != "30" {
+			t.Errorf("Unexpected parameters: %v", r.PostForm)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"sid": "FX1", "status": "queued", "quality": "superfine"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.FaxUrl = srv.URL
+
+	fax, exc, err := twilio.CreateFax(&SendFaxRequest{
+		To:         "+19135551234",
+		From:       "+15005550006",
+		MediaUrl:   "https://example.com/fax.pdf",
+		Quality:    FaxQualitySuperfine,
+		StoreMedia: NewBoolean(false),
+		Ttl:        30,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if fax.Sid != "FX1" {
+		t.Errorf("Unexpected fax: %+v", fax)
+	}
+}
+
+func TestSendFaxPassesThrough(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("Quality") != "best" || r.PostForm.Get("StoreMedia") != "true" {
+			t.Errorf("Unexpected parameters: %v", r.PostForm)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": 400, "code": 20001, "message": "Invalid quality"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.FaxUrl = srv.URL
+
+	_, exc, err := twilio.SendFax("+19135551234", "+15005550006", "https://example.com/fax.pdf", "best", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc == nil || exc.Code != 20001 {
+		t.Errorf("Unexpected exception: %v", exc)
+	}
+}
+
+func TestGetFaxesFilter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("To") != "+19135551234" || q.Get("DateCreatedAfter") != "2020-01-01T00:00:00Z" {
+			t.Errorf("Unexpected filters: %s", r.URL.RawQuery)
+		}
+		if q.Get("Page") == "" {
+			fmt.Fprintf(w, `{"faxes": [{"sid": "FX1", "to": "+19135551234"}], "meta": {"next_page_url": "http://%s/Faxes?%s&Page=1"}}`, r.Host, r.URL.RawQuery)
+			return
+		}
+		fmt.Fprint(w, `{"faxes": [{"sid": "FX2", "to": "+19135551234"}], "meta": {"next_page_url": null}}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.FaxUrl = srv.URL
+
+	faxes, exc, err := twilio.GetFaxes("+19135551234", "", "", "2020-01-01T00:00:00Z")
+	if err != nil || exc != nil {
+		t.Fatalf("Unexpected failure: %v %v", exc, err)
+	}
+	if len(faxes) != 2 || faxes[0].Sid != "FX1" || faxes[1].Sid != "FX2" {
+		t.Errorf("Unexpected faxes: %+v", faxes)
+	}
+}
+
+func TestFaxMedia(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Faxes/FX1/Media":
+			fmt.Fprint(w, `{"media": [{"sid": "ME1", "fax_sid": "FX1", "content_type": "application/pdf"}], "meta": {"next_page_url": null}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/Faxes/FX1":
+			fmt.Fprintf(w, `{"sid": "FX1", "media_sid": "ME1", "media_url": "http://%s/content/ME1.pdf"}`, r.Host)
+		case r.Method == http.MethodGet && r.URL.Path == "/Faxes/FX1/Media/ME1":
+			fmt.Fprint(w, `{"sid": "ME1", "fax_sid": "FX1", "content_type": "application/pdf"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/content/ME1.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			fmt.Fprint(w, "%PDF")
+		case r.Method == http.MethodDelete && r.URL.Path == "/Faxes/FX1/Media/ME1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.FaxUrl = srv.URL
+
+	it := twilio.ListFaxMedia("FX1", nil)
+	var sids []string
+	for it.Next() {
+		sids = append(sids, it.Value().Sid)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sids) != 1 || sids[0] != "ME1" {
+		t.Errorf("Unexpected media: %v", sids)
+	}
+
+	var buf bytes.Buffer
+	contentType, exc, err := twilio.DownloadFaxMedia("FX1", "ME1", &buf)
+	if err != nil || exc != nil {
+		t.Fatalf("Unexpected download failure: %v %v", exc, err)
+	}
+	if contentType != "application/pdf" || buf.String() != "%PDF" {
+		t.Errorf("Unexpected download: %s %q", contentType, buf.String())
+	}
+
+	if _, _, err := twilio.DownloadFaxMedia("FX1", "ME2", &buf); err == nil {
+		t.Error("Expected an error for media of another fax")
+	}
+
+	if exc, err := twilio.DeleteFaxMedia("FX1", "ME1"); err != nil || exc != nil {
+		t.Fatalf("Unexpected delete failure: %v %v", exc, err)
+	}
+}
+
+func TestDecodeFaxStatusWebhook(t *testing.T) {
+	data := url.Values{
+		"FaxSid":          {"FX1"},
+		"FaxStatus":       {"failed"},
+		"RemoteStationId": {"+19135551234"},
+		"ApiVersion":      {"v1"},
+		"NumPages":        {"2"},
+		"ErrorCode":       {"15002"},
+		"ErrorMessage":    {"No answer"},
+	}
+	var hook FaxStatusWebhook
+	if err := DecodeWebhook(data, &hook); err != nil {
+		t.Fatal(err)
+	}
+	if hook.FaxSid != "FX1" || hook.RemoteStationID != "+19135551234" || hook.APIVersion != "v1" ||
+		hook.NumPages != 2 || hook.ErrorCode != 15002 || !hook.IsTerminal() {
+		t.Errorf("Unexpected webhook: %+v", hook)
+	}
+}
@@ -17,6 +17,7 @@ const (
 	videoURL      = "https://video.twilio.com"
 	lookupURL     = "https://lookups.twilio.com/v1" // https://www.twilio.com/docs/lookup/api
 	priceURL      = "https://pricing.twilio.com/v1"
+	faxURL        = "https://fax.twilio.com/v1"
 	clientTimeout = time.Second * 30
 )
 
@@ -34,10 +35,20 @@ type Twilio struct {
 	VideoUrl   string
 	LookupURL  string
 	PriceUrl   string
+	FaxUrl     string
 	HTTPClient *http.Client
 
 	APIKeySid    string
 	APIKeySecret string
+
+	// Middleware is applied, in order, to every request sent to Twilio.
+	Middleware []Middleware
+	// RetryPolicy, when set, retries requests that fail with a 429 or a
+	// transient 5xx response.
+	RetryPolicy *RetryPolicy
+	// RateLimiter, when set, throttles outgoing messages per account and
+	// per sender.
+	RateLimiter *RateLimiter
 }
 
 // Exception is a representation of a twilio exception.
@@ -78,6 +89,7 @@ func NewTwilioClientCustomHTTP(accountSid, authToken string, HTTPClient *http.Cl
 		VideoUrl:   videoURL,
 		LookupURL:  lookupURL,
 		PriceUrl:   priceURL,
+		FaxUrl:     faxURL,
 		HTTPClient: HTTPClient,
 	}
 }
@@ -152,7 +164,11 @@ func (twilio *Twilio) do(req *http.Request) (*http.Response, error) {
 		client = defaultClient
 	}
 
-	return client.Do(req)
+	do := twilio.chain(client.Do)
+	if twilio.RetryPolicy != nil {
+		return twilio.RetryPolicy.do(do, req)
+	}
+	return do(req)
 }
 
 // Build path to a resource within the Twilio account
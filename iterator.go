@@ -0,0 +1,292 @@
+package gotwilio
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// PageOptions control how a list Iterator pages through results.
+type PageOptions struct {
+	PageSize int // number of records requested per page, 0 uses Twilio's default
+	MaxItems int // stop after this many records, 0 means no limit
+}
+
+// Iterator streams the records of a Twilio list resource, fetching pages
+// lazily as they are needed. Both the 2010 API paging style
+// (next_page_uri) and the v1 API style (meta.next_page_url) are supported.
+// See https://www.twilio.com/docs/usage/twilios-response#response-formats-list-paging-information
+//
+// Typical use:
+//
+//	it := twilio.GetFaxesIterator("", "", "", "", nil)
+//	for it.Next() {
+//		fax := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator struct {
+	t        *Twilio
+	ctx      context.Context
+	nextURL  string
+	key      string
+	newValue func() interface{}
+	maxItems int
+
+	page  []json.RawMessage
+	value interface{}
+	count int
+	err   error
+}
+
+func (twilio *Twilio) newIterator(ctx context.Context, twilioUrl, key string, opts *PageOptions, newValue func() interface{}) *Iterator {
+	it := &Iterator{
+		t:        twilio,
+		ctx:      ctx,
+		nextURL:  twilioUrl,
+		key:      key,
+		newValue: newValue,
+	}
+
+	if opts != nil {
+		it.maxItems = opts.MaxItems
+		if opts.PageSize > 0 {
+			u, err := url.Parse(twilioUrl)
+			if err != nil {
+				it.err = err
+				return it
+			}
+			q := u.Query()
+			q.Set("PageSize", strconv.Itoa(opts.PageSize))
+			u.RawQuery = q.Encode()
+			it.nextURL = u.String()
+		}
+	}
+	return it
+}
+
+// Next advances the iterator to the next record, fetching a new page when
+// the current one is exhausted. It returns false when there are no more
+// records or an error occurred.
+func (it *Iterator) Next() bool {
+	if it.err != nil || (it.maxItems > 0 && it.count >= it.maxItems) {
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.nextURL == "" {
+			return false
+		}
+		if it.err = it.fetch(); it.err != nil {
+			return false
+		}
+	}
+
+	value := it.newValue()
+	if it.err = json.Unmarshal(it.page[0], value); it.err != nil {
+		return false
+	}
+	it.page = it.page[1:]
+	it.value = value
+	it.count++
+	return true
+}
+
+// Value returns the current record. The typed iterators returned by the
+// list methods shadow it with a method returning the concrete type.
+func (it *Iterator) Value() interface{} {
+	return it.value
+}
+
+// Err returns the error that stopped the iteration, if any. An error
+// response from Twilio is returned as an *Exception.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+func (it *Iterator) fetch() error {
+	current := it.nextURL
+	it.nextURL = ""
+
+	res, err := it.t.get(it.ctx, current)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		if err := json.Unmarshal(responseBody, exception); err != nil {
+			return err
+		}
+		return exception
+	}
+
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(responseBody, &page); err != nil {
+		return err
+	}
+
+	it.page = nil
+	if raw, ok := page[it.key]; ok {
+		if err := json.Unmarshal(raw, &it.page); err != nil {
+			return err
+		}
+	}
+
+	next, err := nextPageURL(page)
+	if err != nil || next == "" {
+		return err
+	}
+
+	// 2010 API page URIs are relative to the API host.
+	base, err := url.Parse(current)
+	if err != nil {
+		return err
+	}
+	ref, err := url.Parse(next)
+	if err != nil {
+		return err
+	}
+	it.nextURL = base.ResolveReference(ref).String()
+	return nil
+}
+
+// nextPageURL extracts the link to the next page from either paging style.
+func nextPageURL(page map[string]json.RawMessage) (string, error) {
+	var next *string
+	if raw, ok := page["next_page_uri"]; ok {
+		if err := json.Unmarshal(raw, &next); err != nil {
+			return "", err
+		}
+	} else if raw, ok := page["meta"]; ok {
+		var meta struct {
+			NextPageURL *string `json:"next_page_url"`
+		}
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return "", err
+		}
+		next = meta.NextPageURL
+	}
+
+	if next == nil {
+		return "", nil
+	}
+	return *next, nil
+}
+
+// FaxIterator iterates over FaxResource records.
+type FaxIterator struct{ *Iterator }
+
+// Value returns the current fax.
+func (it FaxIterator) Value() *FaxResource {
+	v, _ := it.value.(*FaxResource)
+	return v
+}
+
+// FaxMediaIterator iterates over FaxMediaResource records.
+type FaxMediaIterator struct{ *Iterator }
+
+// Value returns the current fax media.
+func (it FaxMediaIterator) Value() *FaxMediaResource {
+	v, _ := it.value.(*FaxMediaResource)
+	return v
+}
+
+// MessageIterator iterates over MessageResponse records.
+type MessageIterator struct{ *Iterator }
+
+// Value returns the current message.
+func (it MessageIterator) Value() *MessageResponse {
+	v, _ := it.value.(*MessageResponse)
+	return v
+}
+
+// CallIterator iterates over VoiceResponse records.
+type CallIterator struct{ *Iterator }
+
+// Value returns the current call.
+func (it CallIterator) Value() *VoiceResponse {
+	v, _ := it.value.(*VoiceResponse)
+	return v
+}
+
+// VideoRoomIterator iterates over VideoResponse records.
+type VideoRoomIterator struct{ *Iterator }
+
+// Value returns the current video room.
+func (it VideoRoomIterator) Value() *VideoResponse {
+	v, _ := it.value.(*VideoResponse)
+	return v
+}
+
+// ConferenceIterator iterates over Conference records.
+type ConferenceIterator struct{ *Iterator }
+
+// Value returns the current conference.
+func (it ConferenceIterator) Value() *Conference {
+	v, _ := it.value.(*Conference)
+	return v
+}
+
+// ConferenceParticipantIterator iterates over ConferenceParticipant records.
+type ConferenceParticipantIterator struct{ *Iterator }
+
+// Value returns the current conference participant.
+func (it ConferenceParticipantIterator) Value() *ConferenceParticipant {
+	v, _ := it.value.(*ConferenceParticipant)
+	return v
+}
+
+// ParticipantIterator iterates over Proxy Participant records.
+type ParticipantIterator struct{ *Iterator }
+
+// Value returns the current Proxy participant.
+func (it ParticipantIterator) Value() *Participant {
+	v, _ := it.value.(*Participant)
+	return v
+}
+
+// InteractionIterator iterates over Proxy Interaction records.
+type InteractionIterator struct{ *Iterator }
+
+// Value returns the current Proxy interaction.
+func (it InteractionIterator) Value() *Interaction {
+	v, _ := it.value.(*Interaction)
+	return v
+}
+
+// QueueIterator iterates over QueueResponse records.
+type QueueIterator struct{ *Iterator }
+
+// Value returns the current queue.
+func (it QueueIterator) Value() *QueueResponse {
+	v, _ := it.value.(*QueueResponse)
+	return v
+}
+
+// QueueMemberIterator iterates over QueueMember records.
+type QueueMemberIterator struct{ *Iterator }
+
+// Value returns the current queue member.
+func (it QueueMemberIterator) Value() *QueueMember {
+	v, _ := it.value.(*QueueMember)
+	return v
+}
+
+// UsageRecordIterator iterates over UsageRecord records.
+type UsageRecordIterator struct{ *Iterator }
+
+// Value returns the current usage record.
+func (it UsageRecordIterator) Value() *UsageRecord {
+	v, _ := it.value.(*UsageRecord)
+	return v
+}
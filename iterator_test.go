@@ -0,0 +1,97 @@
+package gotwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIteratorNextPageURI(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Page") == "1" {
+			fmt.Fprint(w, `{"usage_records": [{"category": "sms"}], "next_page_uri": null}`)
+			return
+		}
+		if r.URL.Query().Get("PageSize") != "2" {
+			t.Errorf("Expected PageSize=2, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{
+			"usage_records": [{"category": "calls"}, {"category": "mms"}],
+			"next_page_uri": "/Accounts/AC1/Usage/Records.json?Page=1&PageSize=2"
+		}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	it := twilio.GetUsageIterator("", "", "", false, &PageOptions{PageSize: 2})
+	var categories []string
+	for it.Next() {
+		categories = append(categories, it.Value().Category)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(categories) != "[calls mms sms]" {
+		t.Errorf("Expected records from both pages, got %v", categories)
+	}
+}
+
+func TestIteratorMetaNextPageURL(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Page") == "1" {
+			fmt.Fprint(w, `{"rooms": [{"sid": "RM3"}], "meta": {"next_page_url": null}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"rooms": [{"sid": "RM1"}, {"sid": "RM2"}], "meta": {"next_page_url": "%s/v1/Rooms?Page=1"}}`, srv.URL)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("", "")
+	twilio.VideoUrl = srv.URL
+
+	it := twilio.ListVideoRoomsIterator(nil, &PageOptions{MaxItems: 3})
+	var sids []string
+	for it.Next() {
+		sids = append(sids, it.Value().Sid)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(sids) != "[RM1 RM2 RM3]" {
+		t.Errorf("Expected rooms from both pages, got %v", sids)
+	}
+
+	it = twilio.ListVideoRoomsIterator(nil, &PageOptions{MaxItems: 1})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 1 {
+		t.Errorf("Expected MaxItems to stop after 1 record, got %d", count)
+	}
+}
+
+func TestIteratorException(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code": 20404, "message": "The requested resource was not found", "status": 404}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	it := twilio.GetConferenceParticipantsIterator("CF1", nil)
+	if it.Next() {
+		t.Fatal("Expected no records")
+	}
+	exc, ok := it.Err().(*Exception)
+	if !ok || exc.Code != 20404 {
+		t.Fatalf("Expected 20404 exception, got %v", it.Err())
+	}
+}
@@ -0,0 +1,158 @@
+package gotwilio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MessageMedia is a media file attached to a message.
+// See https://www.twilio.com/docs/sms/api/media-resource
+type MessageMedia struct {
+	Sid         string `json:"sid"`
+	AccountSid  string `json:"account_sid"`
+	ParentSid   string `json:"parent_sid"`
+	ContentType string `json:"content_type"`
+	DateCreated string `json:"date_created"`
+	DateUpdated string `json:"date_updated"`
+	Uri         string `json:"uri"`
+}
+
+// MessageMediaIterator iterates over MessageMedia records.
+type MessageMediaIterator struct{ *Iterator }
+
+// Value returns the current media record.
+func (it MessageMediaIterator) Value() *MessageMedia {
+	v, _ := it.value.(*MessageMedia)
+	return v
+}
+
+func (twilio *Twilio) messageMediaUrl(messageSid, mediaSid string) string {
+	return twilio.buildUrl("Messages/" + messageSid + "/Media/" + mediaSid)
+}
+
+// ListMessageMedia returns an iterator over the media attached to a message.
+// See https://www.twilio.com/docs/sms/api/media-resource#read-multiple-media-resources
+func (twilio *Twilio) ListMessageMedia(messageSid string, opts *PageOptions) MessageMediaIterator {
+	return twilio.ListMessageMediaWithContext(context.Background(), messageSid, opts)
+}
+
+func (twilio *Twilio) ListMessageMediaWithContext(ctx context.Context, messageSid string, opts *PageOptions) MessageMediaIterator {
+	twilioUrl := twilio.buildUrl("Messages/" + messageSid + "/Media.json")
+	return MessageMediaIterator{twilio.newIterator(ctx, twilioUrl, "media_list", opts, func() interface{} { return new(MessageMedia) })}
+}
+
+// GetMessageMedia fetches the metadata of a single media file.
+// See https://www.twilio.com/docs/sms/api/media-resource#fetch-a-media-resource
+func (twilio *Twilio) GetMessageMedia(messageSid, mediaSid string) (*MessageMedia, *Exception, error) {
+	return twilio.GetMessageMediaWithContext(context.Background(), messageSid, mediaSid)
+}
+
+func (twilio *Twilio) GetMessageMediaWithContext(ctx context.Context, messageSid, mediaSid string) (*MessageMedia, *Exception, error) {
+	res, err := twilio.get(ctx, twilio.messageMediaUrl(messageSid, mediaSid)+".json")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		err = decoder.Decode(exception)
+		return nil, exception, err
+	}
+
+	media := new(MessageMedia)
+	err = decoder.Decode(media)
+	return media, nil, err
+}
+
+// DownloadMessageMedia streams the content of a media file to w and returns
+// its content type.
+// See https://www.twilio.com/docs/sms/api/media-resource#fetch-a-media-resource
+func (twilio *Twilio) DownloadMessageMedia(messageSid, mediaSid string, w io.Writer) (string, *Exception, error) {
+	return twilio.DownloadMessageMediaWithContext(context.Background(), messageSid, mediaSid, w)
+}
+
+func (twilio *Twilio) DownloadMessageMediaWithContext(ctx context.Context, messageSid, mediaSid string, w io.Writer) (string, *Exception, error) {
+	return twilio.download(ctx, twilio.messageMediaUrl(messageSid, mediaSid), w)
+}
+
+// DeleteMessageMedia removes a media file from a message.
+// See https://www.twilio.com/docs/sms/api/media-resource#delete-a-media-resource
+func (twilio *Twilio) DeleteMessageMedia(messageSid, mediaSid string) (*Exception, error) {
+	return twilio.DeleteMessageMediaWithContext(context.Background(), messageSid, mediaSid)
+}
+
+func (twilio *Twilio) DeleteMessageMediaWithContext(ctx context.Context, messageSid, mediaSid string) (*Exception, error) {
+	res, err := twilio.delete(ctx, twilio.messageMediaUrl(messageSid, mediaSid)+".json")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		exception := new(Exception)
+		err = json.NewDecoder(res.Body).Decode(exception)
+		return exception, err
+	}
+	return nil, nil
+}
+
+// DownloadMediaURL streams the content at a Twilio media URL, such as the
+// ones received in SMSWebhook.MediaUrl0..10, to w using the client's
+// credentials, and returns its content type.
+//
+// Webhook parameters can be forged, so to not leak the credentials the URL
+// must be an https URL on twilio.com or one of its subdomains, or be on the
+// host of BaseUrl or FaxUrl.
+func (twilio *Twilio) DownloadMediaURL(mediaURL string, w io.Writer) (string, *Exception, error) {
+	return twilio.DownloadMediaURLWithContext(context.Background(), mediaURL, w)
+}
+
+func (twilio *Twilio) DownloadMediaURLWithContext(ctx context.Context, mediaURL string, w io.Writer) (string, *Exception, error) {
+	if !twilio.isTwilioURL(mediaURL) {
+		return "", nil, fmt.Errorf("refusing to send credentials to %q, not a Twilio URL", mediaURL)
+	}
+	return twilio.download(ctx, mediaURL, w)
+}
+
+// isTwilioURL reports whether the client's credentials can be sent to
+// twilioUrl.
+func (twilio *Twilio) isTwilioURL(twilioUrl string) bool {
+	u, err := url.Parse(twilioUrl)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	for _, baseUrl := range []string{twilio.BaseUrl, twilio.FaxUrl} {
+		if base, err := url.Parse(baseUrl); err == nil && base.Host != "" &&
+			u.Scheme == base.Scheme && strings.EqualFold(u.Host, base.Host) {
+			return true
+		}
+	}
+	host := strings.ToLower(u.Hostname())
+	return u.Scheme == "https" && (host == "twilio.com" || strings.HasSuffix(host, ".twilio.com"))
+}
+
+// download streams the body of a GET request to w.
+func (twilio *Twilio) download(ctx context.Context, twilioUrl string, w io.Writer) (string, *Exception, error) {
+	res, err := twilio.get(ctx, twilioUrl)
+	if err != nil {
+		return "", nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		err = json.NewDecoder(res.Body).Decode(exception)
+		return "", exception, err
+	}
+
+	_, err = io.Copy(w, res.Body)
+	return res.Header.Get("Content-Type"), nil, err
+}
@@ -0,0 +1,104 @@
+package gotwilio
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDownloadMessageMedia(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "AC1" || pass != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"code": 20003, "message": "Authenticate", "status": 401}`)
+			return
+		}
+		if r.URL.Path != "/Accounts/AC1/Messages/MM1/Media/ME1" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "png data")
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "token")
+	twilio.BaseUrl = srv.URL
+
+	var buf bytes.Buffer
+	contentType, exc, err := twilio.DownloadMessageMedia("MM1", "ME1", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if contentType != "image/png" || buf.String() != "png data" {
+		t.Errorf("Unexpected download: %s %q", contentType, buf.String())
+	}
+}
+
+func TestDownloadMediaURLHosts(t *testing.T) {
+	requested := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "png data")
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "token")
+
+	var buf bytes.Buffer
+	if _, _, err := twilio.DownloadMediaURL(srv.URL+"/media/0", &buf); err == nil {
+		t.Error("expected an error for a host that isn't Twilio's")
+	}
+	if requested {
+		t.Error("the credentials were sent to a host that isn't Twilio's")
+	}
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{"https://api.twilio.com/2010-04-01/Accounts/AC1/Messages/MM1/Media/ME1", true},
+		{"https://media.twiliocdn.com/ME1", false},
+		{"https://api.twilio.com.example.com/media/0", false},
+		{"https://eviltwilio.com/media/0", false},
+		{"http://api.twilio.com/media/0", false},
+		{"/media/0", false},
+	}
+	for _, test := range tests {
+		if got := twilio.isTwilioURL(test.url); got != test.allowed {
+			t.Errorf("%s: expected %t, got %t", test.url, test.allowed, got)
+		}
+	}
+
+	twilio.BaseUrl = srv.URL
+	if _, _, err := twilio.DownloadMediaURL(srv.URL+"/media/0", &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "png data" {
+		t.Errorf("Unexpected download: %q", buf.String())
+	}
+}
+
+func TestSMSWebhookMediaURLs(t *testing.T) {
+	var hook SMSWebhook
+	err := DecodeWebhook(url.Values{
+		"NumMedia":          {"2"},
+		"MediaUrl0":         {"https://api.twilio.com/media/0"},
+		"MediaContentType0": {"image/jpeg"},
+		"MediaUrl1":         {"https://api.twilio.com/media/1"},
+	}, &hook)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	urls := hook.MediaURLs()
+	if len(urls) != 2 || urls[1] != "https://api.twilio.com/media/1" {
+		t.Errorf("Unexpected media URLs: %v", urls)
+	}
+}
@@ -0,0 +1,59 @@
+package gotwilio
+
+import (
+	"net/http"
+)
+
+// DoFunc sends a single HTTP request to Twilio and returns the response.
+// It has the same signature as http.Client.Do.
+type DoFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a DoFunc with additional behaviour such as logging,
+// metrics, header injection or fault injection. A Middleware must call
+// next to pass the request on, or return its own response/error to
+// short-circuit the request.
+type Middleware func(next DoFunc) DoFunc
+
+// Use appends middleware to the client. Middleware registered first is the
+// outermost, so it sees the request first and the response last. It applies
+// to every request made by the client, including the follow-up requests
+// made when paging through list resources.
+func (twilio *Twilio) Use(middleware ...Middleware) *Twilio {
+	twilio.Middleware = append(twilio.Middleware, middleware...)
+	return twilio
+}
+
+// RequestHook returns a Middleware that calls fn with every outgoing request
+// before it is sent. Returning an error from fn aborts the request and the
+// error is returned to the caller.
+func RequestHook(fn func(req *http.Request) error) Middleware {
+	return func(next DoFunc) DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := fn(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// ResponseHook returns a Middleware that calls fn with every request once it
+// has completed, along with the response and transport error (either of
+// which may be nil). Whatever fn returns is passed back to the caller, so it
+// may inspect, replace or suppress the response.
+func ResponseHook(fn func(req *http.Request, resp *http.Response, err error) (*http.Response, error)) Middleware {
+	return func(next DoFunc) DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			return fn(req, resp, err)
+		}
+	}
+}
+
+// chain wraps the client's transport with its middleware.
+func (twilio *Twilio) chain(do DoFunc) DoFunc {
+	for i := len(twilio.Middleware) - 1; i >= 0; i-- {
+		do = twilio.Middleware[i](do)
+	}
+	return do
+}
@@ -0,0 +1,96 @@
+package gotwilio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Test") != "injected" {
+			t.Errorf("Expected injected header, got %q", r.Header.Get("X-Test"))
+		}
+		fmt.Fprintf(w, testUsageResponse)
+	}))
+	defer srv.Close()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next DoFunc) DoFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next(req)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+
+	twilio := NewTwilioClient("", "")
+	twilio.BaseUrl = srv.URL
+	twilio.Use(trace("outer"), trace("inner"))
+	twilio.Use(RequestHook(func(req *http.Request) error {
+		req.Header.Set("X-Test", "injected")
+		return nil
+	}))
+
+	_, exc, err := twilio.GetUsage("", "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+
+	expected := []string{"outer before", "inner before", "inner after", "outer after"}
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not have reached the server")
+	}))
+	defer srv.Close()
+
+	injected := errors.New("injected fault")
+
+	twilio := NewTwilioClient("", "")
+	twilio.BaseUrl = srv.URL
+	twilio.Use(RequestHook(func(req *http.Request) error {
+		return injected
+	}))
+
+	_, _, err := twilio.GetUsage("", "", "", false)
+	if !errors.Is(err, injected) {
+		t.Fatalf("Expected injected fault, got %v", err)
+	}
+}
+
+func TestResponseHook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, testUsageResponse)
+	}))
+	defer srv.Close()
+
+	var status int
+	twilio := NewTwilioClient("", "")
+	twilio.BaseUrl = srv.URL
+	twilio.Use(ResponseHook(func(req *http.Request, resp *http.Response, err error) (*http.Response, error) {
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		return resp, err
+	}))
+
+	if _, _, err := twilio.GetUsage("", "", "", false); err != nil {
+		t.Fatal(err)
+	}
+	if status != http.StatusOK {
+		t.Errorf("Expected hook to see status 200, got %d", status)
+	}
+}
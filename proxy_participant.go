@@ -154,6 +154,17 @@ func (session *ProxySession) ListParticipantsWithContext(ctx context.Context) (r
 
 }
 
+// ListParticipantsIterator returns an iterator over all participants of the
+// session, fetching pages as they are needed.
+func (session *ProxySession) ListParticipantsIterator(opts *PageOptions) ParticipantIterator {
+	return session.ListParticipantsIteratorWithContext(context.Background(), opts)
+}
+
+func (session *ProxySession) ListParticipantsIteratorWithContext(ctx context.Context, opts *PageOptions) ParticipantIterator {
+	twilioUrl := fmt.Sprintf("%s/%s/%s/%s/%s/%s", ProxyBaseUrl, "Services", session.ServiceSid, "Sessions", session.Sid, "Participants")
+	return ParticipantIterator{session.twilio.newIterator(ctx, twilioUrl, "participants", opts, func() interface{} { return new(Participant) })}
+}
+
 func (session *ProxySession) GetParticipant(participantID string) (response Participant, exception *Exception, err error) {
 	return session.GetParticipantWithContext(context.Background(), participantID)
 }
@@ -294,6 +305,17 @@ func (session *ProxySession) GetInteractionsWithContext(ctx context.Context) (re
 	return response, exception, err
 }
 
+// GetInteractionsIterator returns an iterator over all interactions of the
+// session, fetching pages as they are needed.
+func (session *ProxySession) GetInteractionsIterator(opts *PageOptions) InteractionIterator {
+	return session.GetInteractionsIteratorWithContext(context.Background(), opts)
+}
+
+func (session *ProxySession) GetInteractionsIteratorWithContext(ctx context.Context, opts *PageOptions) InteractionIterator {
+	twilioUrl := fmt.Sprintf("%s/%s/%s/%s/%s/%s", ProxyBaseUrl, "Services", session.ServiceSid, "Sessions", session.Sid, "Interactions")
+	return InteractionIterator{session.twilio.newIterator(ctx, twilioUrl, "interactions", opts, func() interface{} { return new(Interaction) })}
+}
+
 // Form values initialization
 func participantFormValues(req ParticipantRequest) url.Values {
 	formValues := url.Values{}
@@ -3,18 +3,58 @@ package gotwilio
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 const (
 	ErrorQueueAlreadyExists ExceptionCode = 22003
 )
 
+// QueueMemberFront can be passed as the call Sid of GetQueueMember and
+// DequeueMember to select the call at the front of the queue.
+const QueueMemberFront = "Front"
+
+// QueueResponse is a queue of calls.
+// See https://www.twilio.com/docs/voice/api/queue-resource
 type QueueResponse struct {
-	Sid          string `json:"sid"`
-	FriendlyName string `json:"friendly_name"`
-	MaxSize      int    `json:"max_size"`
+	Sid             string `json:"sid"`
+	AccountSid      string `json:"account_sid"`
+	FriendlyName    string `json:"friendly_name"`
+	MaxSize         int    `json:"max_size"`
+	CurrentSize     int    `json:"current_size"`
+	AverageWaitTime int    `json:"average_wait_time"`
+	DateCreated     string `json:"date_created"`
+	DateUpdated     string `json:"date_updated"`
+	Uri             string `json:"uri"`
+}
+
+// QueueOptions are used for updating queues.
+type QueueOptions struct {
+	FriendlyName string `url:"FriendlyName,omitempty"`
+	MaxSize      int    `url:"MaxSize,omitempty"`
+}
+
+// QueueMember is a call waiting in a queue.
+// See https://www.twilio.com/docs/voice/api/member-resource
+type QueueMember struct {
+	CallSid      string `json:"call_sid"`
+	QueueSid     string `json:"queue_sid"`
+	DateEnqueued string `json:"date_enqueued"`
+	Position     int    `json:"position"`
+	WaitTime     int    `json:"wait_time"`
+	Uri          string `json:"uri"`
+}
+
+// DateEnqueuedAsTime returns QueueMember.DateEnqueued as a time.Time object
+// instead of a string.
+func (m *QueueMember) DateEnqueuedAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, m.DateEnqueued)
 }
 
 func (twilio *Twilio) CreateQueue(friendlyName string) (*QueueResponse, *Exception, error) {
@@ -50,3 +90,186 @@ func (twilio *Twilio) CreateQueueWithContext(ctx context.Context, friendlyName s
 	err = decoder.Decode(queueResponse)
 	return queueResponse, exception, err
 }
+
+// GetOrCreateQueue returns the queue named friendlyName, creating it if it
+// doesn't exist yet.
+func (twilio *Twilio) GetOrCreateQueue(friendlyName string) (*QueueResponse, *Exception, error) {
+	return twilio.GetOrCreateQueueWithContext(context.Background(), friendlyName)
+}
+
+func (twilio *Twilio) GetOrCreateQueueWithContext(ctx context.Context, friendlyName string) (*QueueResponse, *Exception, error) {
+	queue, exception, err := twilio.CreateQueueWithContext(ctx, friendlyName)
+	if exception == nil || exception.Code != ErrorQueueAlreadyExists {
+		return queue, exception, err
+	}
+
+	it := twilio.ListQueuesWithContext(ctx, nil)
+	for it.Next() {
+		if it.Value().FriendlyName == friendlyName {
+			return it.Value(), nil, nil
+		}
+	}
+	if exc, ok := it.Err().(*Exception); ok {
+		return nil, exc, nil
+	}
+	if err := it.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	// the queue was deleted since it was created, report the original error
+	return nil, exception, nil
+}
+
+// GetQueue fetches a queue.
+// See https://www.twilio.com/docs/voice/api/queue-resource#fetch-a-queue-resource
+func (twilio *Twilio) GetQueue(queueSid string) (*QueueResponse, *Exception, error) {
+	return twilio.GetQueueWithContext(context.Background(), queueSid)
+}
+
+func (twilio *Twilio) GetQueueWithContext(ctx context.Context, queueSid string) (*QueueResponse, *Exception, error) {
+	res, err := twilio.get(ctx, twilio.buildUrl("Queues/"+queueSid+".json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queue := new(QueueResponse)
+	exception, err := decodeQueueResponse(res, http.StatusOK, queue)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return queue, nil, nil
+}
+
+// ListQueues returns an iterator over the queues of the account.
+// See https://www.twilio.com/docs/voice/api/queue-resource#read-multiple-queue-resources
+func (twilio *Twilio) ListQueues(opts *PageOptions) QueueIterator {
+	return twilio.ListQueuesWithContext(context.Background(), opts)
+}
+
+func (twilio *Twilio) ListQueuesWithContext(ctx context.Context, opts *PageOptions) QueueIterator {
+	twilioUrl := twilio.buildUrl("Queues.json")
+	return QueueIterator{twilio.newIterator(ctx, twilioUrl, "queues", opts, func() interface{} { return new(QueueResponse) })}
+}
+
+// UpdateQueue changes the name or maximum size of a queue.
+// See https://www.twilio.com/docs/voice/api/queue-resource#update-a-queue-resource
+func (twilio *Twilio) UpdateQueue(queueSid string, options *QueueOptions) (*QueueResponse, *Exception, error) {
+	return twilio.UpdateQueueWithContext(context.Background(), queueSid, options)
+}
+
+func (twilio *Twilio) UpdateQueueWithContext(ctx context.Context, queueSid string, options *QueueOptions) (*QueueResponse, *Exception, error) {
+	form, err := query.Values(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := twilio.post(ctx, form, twilio.buildUrl("Queues/"+queueSid+".json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queue := new(QueueResponse)
+	exception, err := decodeQueueResponse(res, http.StatusOK, queue)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return queue, nil, nil
+}
+
+// DeleteQueue removes a queue. A queue can only be deleted once it's empty.
+// See https://www.twilio.com/docs/voice/api/queue-resource#delete-a-queue-resource
+func (twilio *Twilio) DeleteQueue(queueSid string) (*Exception, error) {
+	return twilio.DeleteQueueWithContext(context.Background(), queueSid)
+}
+
+func (twilio *Twilio) DeleteQueueWithContext(ctx context.Context, queueSid string) (*Exception, error) {
+	res, err := twilio.delete(ctx, twilio.buildUrl("Queues/"+queueSid+".json"))
+	if err != nil {
+		return nil, err
+	}
+	return decodeQueueResponse(res, http.StatusNoContent, nil)
+}
+
+// ListQueueMembers returns an iterator over the calls waiting in a queue,
+// front first.
+// See https://www.twilio.com/docs/voice/api/member-resource#read-multiple-member-resources
+func (twilio *Twilio) ListQueueMembers(queueSid string, opts *PageOptions) QueueMemberIterator {
+	return twilio.ListQueueMembersWithContext(context.Background(), queueSid, opts)
+}
+
+func (twilio *Twilio) ListQueueMembersWithContext(ctx context.Context, queueSid string, opts *PageOptions) QueueMemberIterator {
+	twilioUrl := twilio.buildUrl("Queues/" + queueSid + "/Members.json")
+	return QueueMemberIterator{twilio.newIterator(ctx, twilioUrl, "queue_members", opts, func() interface{} { return new(QueueMember) })}
+}
+
+// GetQueueMember fetches a call waiting in a queue. Pass QueueMemberFront as
+// callSid to fetch the call at the front of the queue.
+// See https://www.twilio.com/docs/voice/api/member-resource#fetch-a-member-resource
+func (twilio *Twilio) GetQueueMember(queueSid, callSid string) (*QueueMember, *Exception, error) {
+	return twilio.GetQueueMemberWithContext(context.Background(), queueSid, callSid)
+}
+
+func (twilio *Twilio) GetQueueMemberWithContext(ctx context.Context, queueSid, callSid string) (*QueueMember, *Exception, error) {
+	res, err := twilio.get(ctx, twilio.buildUrl("Queues/"+queueSid+"/Members/"+callSid+".json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(QueueMember)
+	exception, err := decodeQueueResponse(res, http.StatusOK, member)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return member, nil, nil
+}
+
+// DequeueMember removes a call from a queue and redirects it to the TwiML at
+// redirectUrl, fetched with method ("GET" or "POST", POST if empty). Pass
+// QueueMemberFront as callSid to dequeue the call at the front of the queue.
+// See https://www.twilio.com/docs/voice/api/member-resource#update-a-member-resource
+func (twilio *Twilio) DequeueMember(queueSid, callSid, redirectUrl, method string) (*QueueMember, *Exception, error) {
+	return twilio.DequeueMemberWithContext(context.Background(), queueSid, callSid, redirectUrl, method)
+}
+
+func (twilio *Twilio) DequeueMemberWithContext(ctx context.Context, queueSid, callSid, redirectUrl, method string) (*QueueMember, *Exception, error) {
+	if redirectUrl == "" {
+		return nil, nil, errors.New("a URL is required to dequeue a call")
+	}
+	if method != "" && method != http.MethodGet && method != http.MethodPost {
+		return nil, nil, fmt.Errorf("unsupported method %q, must be GET or POST", method)
+	}
+
+	formValues := url.Values{}
+	formValues.Set("Url", redirectUrl)
+	if method != "" {
+		formValues.Set("Method", method)
+	}
+
+	res, err := twilio.post(ctx, formValues, twilio.buildUrl("Queues/"+queueSid+"/Members/"+callSid+".json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(QueueMember)
+	exception, err := decodeQueueResponse(res, http.StatusOK, member)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return member, nil, nil
+}
+
+// decodeQueueResponse closes the body of res after decoding it into v, if
+// res has the expected status code, or into an Exception.
+func decodeQueueResponse(res *http.Response, expected int, v interface{}) (*Exception, error) {
+	defer res.Body.Close()
+
+	if res.StatusCode != expected {
+		exception := new(Exception)
+		err := json.NewDecoder(res.Body).Decode(exception)
+		return exception, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return nil, json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,73 @@
+package gotwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetOrCreateQueue(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code": 22003, "message": "Queue already exists", "status": 400}`)
+		case http.MethodGet:
+			if r.URL.Path != "/Accounts/AC1/Queues.json" {
+				t.Errorf("Unexpected path: %s", r.URL.Path)
+			}
+			fmt.Fprint(w, `{"queues": [
+				{"sid": "QU1", "friendly_name": "billing", "current_size": 0},
+				{"sid": "QU2", "friendly_name": "support", "current_size": 3, "average_wait_time": 42, "max_size": 100}
+			], "next_page_uri": null}`)
+		}
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	queue, exc, err := twilio.GetOrCreateQueue("support")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if queue.Sid != "QU2" || queue.CurrentSize != 3 || queue.AverageWaitTime != 42 {
+		t.Errorf("Unexpected queue: %+v", queue)
+	}
+
+	if _, exc, _ = twilio.GetOrCreateQueue("sales"); exc == nil || exc.Code != ErrorQueueAlreadyExists {
+		t.Errorf("Expected the original exception, got %v", exc)
+	}
+}
+
+func TestDequeueMember(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/Accounts/AC1/Queues/QU1/Members/Front.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.PostForm.Get("Url") != "https://example.com/agent" || r.PostForm.Get("Method") != "GET" {
+			t.Errorf("Unexpected parameters: %v", r.PostForm)
+		}
+		fmt.Fprint(w, `{"call_sid": "CA1", "queue_sid": "QU1", "position": 1, "wait_time": 30}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	member, exc, err := twilio.DequeueMember("QU1", QueueMemberFront, "https://example.com/agent", "GET")
+	if err != nil || exc != nil {
+		t.Fatalf("Unexpected failure: %v %v", exc, err)
+	}
+	if member.CallSid != "CA1" || member.WaitTime != 30 {
+		t.Errorf("Unexpected member: %+v", member)
+	}
+	if _, _, err := twilio.DequeueMember("QU1", QueueMemberFront, "https://example.com/agent", "PUT"); err == nil {
+		t.Error("expected an error for an unsupported method")
+	}
+}
@@ -0,0 +1,182 @@
+package gotwilio
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned when a message cannot be sent before the
+// context deadline without exceeding the configured rate limits.
+var ErrRateLimited = errors.New("gotwilio: rate limit wait would exceed context deadline")
+
+// RateLimit is a token bucket configuration. Rate is the sustained number of
+// messages per second and Burst the number that may be sent at once. A zero
+// Rate disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiter throttles outgoing messages on the client side, both for the
+// account as a whole and for each sender (From number or
+// MessagingServiceSid), so that Twilio's per-number throughput is not
+// exceeded.
+// See https://help.twilio.com/articles/115002943027-Understanding-Twilio-Rate-Limits-and-Message-Queues
+type RateLimiter struct {
+	Account          RateLimit // shared by every message sent by the client
+	LongCode         RateLimit // per long code sender
+	TollFree         RateLimit // per toll-free sender
+	ShortCode        RateLimit // per short code sender
+	MessagingService RateLimit // per Messaging Service
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewRateLimiter returns a RateLimiter using Twilio's default throughput for
+// each number type. The account and Messaging Service limits are disabled.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		LongCode:  RateLimit{Rate: 1, Burst: 1},
+		TollFree:  RateLimit{Rate: 3, Burst: 3},
+		ShortCode: RateLimit{Rate: 100, Burst: 100},
+	}
+}
+
+// WithRateLimiter enables client side rate limiting of outgoing messages.
+func (twilio *Twilio) WithRateLimiter(limiter *RateLimiter) *Twilio {
+	twilio.RateLimiter = limiter
+	return twilio
+}
+
+// limitFor returns the limit that applies to the given sender.
+func (l *RateLimiter) limitFor(sender string) RateLimit {
+	sender = strings.TrimPrefix(sender, "whatsapp:")
+	switch {
+	case strings.HasPrefix(sender, "MG"):
+		return l.MessagingService
+	case isShortCode(sender):
+		return l.ShortCode
+	case isTollFree(sender):
+		return l.TollFree
+	}
+	return l.LongCode
+}
+
+func isShortCode(number string) bool {
+	if len(number) < 3 || len(number) > 8 {
+		return false
+	}
+	for _, c := range number {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isTollFree reports whether number is a North American toll-free number.
+func isTollFree(number string) bool {
+	if !strings.HasPrefix(number, "+1") || len(number) != 12 {
+		return false
+	}
+	switch number[2:5] {
+	case "800", "833", "844", "855", "866", "877", "888":
+		return true
+	}
+	return false
+}
+
+// Wait blocks until a message may be sent by accountSid from sender. It
+// returns ErrRateLimited straight away if the wait would outlast the
+// context deadline, or the context error if the context is done first.
+func (l *RateLimiter) Wait(ctx context.Context, accountSid, sender string) error {
+	var reserved []*tokenBucket
+	var wait time.Duration
+	now := time.Now()
+
+	l.mu.Lock()
+	if l.buckets == nil {
+		l.buckets = make(map[string]*tokenBucket)
+	}
+	for _, key := range []struct {
+		name  string
+		limit RateLimit
+	}{
+		{"account:" + accountSid, l.Account},
+		{"sender:" + accountSid + ":" + sender, l.limitFor(sender)},
+	} {
+		if key.limit.Rate <= 0 {
+			continue
+		}
+		b, ok := l.buckets[key.name]
+		if !ok {
+			b = newTokenBucket(key.limit, now)
+			l.buckets[key.name] = b
+		}
+		if w := b.reserve(now); w > wait {
+			wait = w
+		}
+		reserved = append(reserved, b)
+	}
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	cancel := func() {
+		l.mu.Lock()
+		for _, b := range reserved {
+			b.tokens = math.Min(b.tokens+1, float64(b.limit.Burst))
+		}
+		l.mu.Unlock()
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && now.Add(wait).After(deadline) {
+		cancel()
+		return ErrRateLimited
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+}
+
+// reserve takes a token, letting the balance go negative, and returns how
+// long the caller has to wait before the token is actually available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.Rate
+	if b.tokens > float64(b.limit.Burst) {
+		b.tokens = float64(b.limit.Burst)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.limit.Rate * float64(time.Second))
+}
@@ -0,0 +1,77 @@
+package gotwilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterSenderTypes(t *testing.T) {
+	l := NewRateLimiter()
+	l.MessagingService = RateLimit{Rate: 50}
+
+	tests := []struct {
+		sender   string
+		expected RateLimit
+	}{
+		{"+15005550006", l.LongCode},
+		{"+18005550006", l.TollFree},
+		{"whatsapp:+18885550006", l.TollFree},
+		{"12345", l.ShortCode},
+		{"MG9752274e9e519418a7406176694466fa", l.MessagingService},
+	}
+	for _, test := range tests {
+		if got := l.limitFor(test.sender); got != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.sender, test.expected, got)
+		}
+	}
+}
+
+func TestRateLimiterFailsFast(t *testing.T) {
+	l := &RateLimiter{LongCode: RateLimit{Rate: 1, Burst: 1}}
+
+	if err := l.Wait(context.Background(), "AC1", "+15005550006"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.Wait(ctx, "AC1", "+15005550006"); err != ErrRateLimited {
+		t.Fatalf("Expected ErrRateLimited, got %v", err)
+	}
+	if time.Since(start) > 50*time.Millisecond {
+		t.Error("Expected Wait to fail without blocking")
+	}
+
+	// A different sender has its own bucket.
+	if err := l.Wait(ctx, "AC1", "+15005550007"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendSMSRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"sid": "SM123"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "").WithRateLimiter(&RateLimiter{
+		LongCode: RateLimit{Rate: 20, Burst: 1},
+	})
+	twilio.BaseUrl = srv.URL
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, _, err := twilio.SendSMS("+15005550006", "+19135551234", "hi", "", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected sends to be throttled, took %v", elapsed)
+	}
+}
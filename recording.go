@@ -0,0 +1,345 @@
+package gotwilio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RecordingStatus is the status of a call or conference recording.
+// See https://www.twilio.com/docs/voice/api/recording#recording-status-values
+type RecordingStatus string
+
+const (
+	RecordingStatusInProgress RecordingStatus = "in-progress"
+	RecordingStatusPaused     RecordingStatus = "paused"
+	RecordingStatusStopped    RecordingStatus = "stopped"
+	RecordingStatusProcessing RecordingStatus = "processing"
+	RecordingStatusCompleted  RecordingStatus = "completed"
+	RecordingStatusAbsent     RecordingStatus = "absent"
+	RecordingStatusFailed     RecordingStatus = "failed"
+	RecordingStatusDeleted    RecordingStatus = "deleted"
+)
+
+// Values of the PauseBehavior of UpdateCallRecording.
+const (
+	// RecordingPauseBehaviorSkip leaves the paused part out of the recording.
+	RecordingPauseBehaviorSkip = "skip"
+	// RecordingPauseBehaviorSilence replaces the paused part with silence.
+	RecordingPauseBehaviorSilence = "silence"
+)
+
+// CurrentRecording can be passed as the recording Sid of UpdateCallRecording
+// and UpdateConferenceRecording to update the recording currently active.
+const CurrentRecording = "Twilio.CURRENT"
+
+// RecordingFormat is the audio format of a downloaded recording.
+type RecordingFormat string
+
+const (
+	RecordingFormatWAV RecordingFormat = "wav"
+	RecordingFormatMP3 RecordingFormat = "mp3"
+)
+
+// Recording is a recording of a call or conference.
+// See https://www.twilio.com/docs/voice/api/recording
+type Recording struct {
+	Sid           string          `json:"sid"`
+	AccountSid    string          `json:"account_sid"`
+	CallSid       string          `json:"call_sid"`
+	ConferenceSid string          `json:"conference_sid"`
+	DateCreated   string          `json:"date_created"`
+	DateUpdated   string          `json:"date_updated"`
+	StartTime     string          `json:"start_time"`
+	Duration      int             `json:"duration,string"`
+	Price         *string         `json:"price,omitempty"`
+	PriceUnit     string          `json:"price_unit"`
+	Status        RecordingStatus `json:"status"`
+	Channels      int             `json:"channels"`
+	Source        string          `json:"source"`
+	Track         string          `json:"track"`
+	ErrorCode     ExceptionCode   `json:"error_code"`
+	Uri           string          `json:"uri"`
+	MediaUrl      string          `json:"media_url"`
+}
+
+// DateCreatedAsTime returns Recording.DateCreated as a time.Time object
+// instead of a string.
+func (r *Recording) DateCreatedAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, r.DateCreated)
+}
+
+// StartTimeAsTime returns Recording.StartTime as a time.Time object
+// instead of a string.
+func (r *Recording) StartTimeAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, r.StartTime)
+}
+
+// RecordingIterator iterates over Recording records.
+type RecordingIterator struct{ *Iterator }
+
+// Value returns the current recording.
+func (it RecordingIterator) Value() *Recording {
+	v, _ := it.value.(*Recording)
+	return v
+}
+
+// CallRecordingOptions are the optional parameters of StartCallRecording.
+type CallRecordingOptions struct {
+	RecordingStatusCallback       string   // Optional
+	RecordingStatusCallbackMethod string   // Optional
+	RecordingStatusCallbackEvent  []string // Optional, "in-progress", "completed" or "absent"
+	RecordingChannels             string   // Optional, "mono" or "dual"
+	RecordingTrack                string   // Optional, "inbound", "outbound" or "both"
+	Trim                          string   // Optional, "trim-silence" or "do-not-trim"
+}
+
+func (o *CallRecordingOptions) formValues() url.Values {
+	formValues := url.Values{}
+	if o == nil {
+		return formValues
+	}
+	if o.RecordingStatusCallback != "" {
+		formValues.Set("RecordingStatusCallback", o.RecordingStatusCallback)
+	}
+	if o.RecordingStatusCallbackMethod != "" {
+		formValues.Set("RecordingStatusCallbackMethod", o.RecordingStatusCallbackMethod)
+	}
+	for _, event := range o.RecordingStatusCallbackEvent {
+		formValues.Add("RecordingStatusCallbackEvent", event)
+	}
+	if o.RecordingChannels != "" {
+		formValues.Set("RecordingChannels", o.RecordingChannels)
+	}
+	if o.RecordingTrack != "" {
+		formValues.Set("RecordingTrack", o.RecordingTrack)
+	}
+	if o.Trim != "" {
+		formValues.Set("Trim", o.Trim)
+	}
+	return formValues
+}
+
+// RecordingFilter narrows down the recordings returned by ListRecordings.
+// Zero values are ignored.
+type RecordingFilter struct {
+	CallSid           string
+	ConferenceSid     string
+	DateCreated       time.Time // only recordings created on this day (UTC)
+	DateCreatedBefore time.Time // only recordings created on or before this time
+	DateCreatedAfter  time.Time // only recordings created on or after this time
+}
+
+func (f *RecordingFilter) values() url.Values {
+	values := url.Values{}
+	if f == nil {
+		return values
+	}
+	if f.CallSid != "" {
+		values.Set("CallSid", f.CallSid)
+	}
+	if f.ConferenceSid != "" {
+		values.Set("ConferenceSid", f.ConferenceSid)
+	}
+	if !f.DateCreated.IsZero() {
+		values.Set("DateCreated", f.DateCreated.UTC().Format("2006-01-02"))
+	}
+	if !f.DateCreatedBefore.IsZero() {
+		values.Set("DateCreated<", f.DateCreatedBefore.UTC().Format(time.RFC3339))
+	}
+	if !f.DateCreatedAfter.IsZero() {
+		values.Set("DateCreated>", f.DateCreatedAfter.UTC().Format(time.RFC3339))
+	}
+	return values
+}
+
+// StartCallRecording starts recording a live call.
+// See https://www.twilio.com/docs/voice/api/recording#create-a-recording-resource
+func (twilio *Twilio) StartCallRecording(callSid string, opts *CallRecordingOptions) (*Recording, *Exception, error) {
+	return twilio.StartCallRecordingWithContext(context.Background(), callSid, opts)
+}
+
+func (twilio *Twilio) StartCallRecordingWithContext(ctx context.Context, callSid string, opts *CallRecordingOptions) (*Recording, *Exception, error) {
+	return twilio.recordingPost(ctx, twilio.buildUrl("Calls/"+callSid+"/Recordings.json"), opts.formValues())
+}
+
+// UpdateCallRecording pauses, resumes or stops a call recording. Pass
+// CurrentRecording as recordingSid to update the active recording of the
+// call. pauseBehavior, RecordingPauseBehaviorSkip or
+// RecordingPauseBehaviorSilence, may only be set when pausing.
+// See https://www.twilio.com/docs/voice/api/recording#update-a-recording-resource
+func (twilio *Twilio) UpdateCallRecording(callSid, recordingSid string, status RecordingStatus, pauseBehavior string) (*Recording, *Exception, error) {
+	return twilio.UpdateCallRecordingWithContext(context.Background(), callSid, recordingSid, status, pauseBehavior)
+}
+
+func (twilio *Twilio) UpdateCallRecordingWithContext(ctx context.Context, callSid, recordingSid string, status RecordingStatus, pauseBehavior string) (*Recording, *Exception, error) {
+	formValues, err := recordingUpdateValues(status, pauseBehavior)
+	if err != nil {
+		return nil, nil, err
+	}
+	return twilio.recordingPost(ctx, twilio.buildUrl("Calls/"+callSid+"/Recordings/"+recordingSid+".json"), formValues)
+}
+
+// UpdateConferenceRecording pauses, resumes or stops a conference recording.
+// Pass CurrentRecording as recordingSid to update the active recording of
+// the conference. pauseBehavior may only be set when pausing.
+// See https://www.twilio.com/docs/voice/api/conference-recording-resource#update-a-recording-resource
+func (twilio *Twilio) UpdateConferenceRecording(conferenceSid, recordingSid string, status RecordingStatus, pauseBehavior string) (*Recording, *Exception, error) {
+	return twilio.UpdateConferenceRecordingWithContext(context.Background(), conferenceSid, recordingSid, status, pauseBehavior)
+}
+
+func (twilio *Twilio) UpdateConferenceRecordingWithContext(ctx context.Context, conferenceSid, recordingSid string, status RecordingStatus, pauseBehavior string) (*Recording, *Exception, error) {
+	formValues, err := recordingUpdateValues(status, pauseBehavior)
+	if err != nil {
+		return nil, nil, err
+	}
+	return twilio.recordingPost(ctx, twilio.buildUrl("Conferences/"+conferenceSid+"/Recordings/"+recordingSid+".json"), formValues)
+}
+
+// recordingUpdateValues checks and encodes the parameters of a recording
+// update, shared by call and conference recordings.
+func recordingUpdateValues(status RecordingStatus, pauseBehavior string) (url.Values, error) {
+	switch status {
+	case RecordingStatusInProgress, RecordingStatusPaused, RecordingStatusStopped:
+	default:
+		return nil, fmt.Errorf("can't update a recording to %q", status)
+	}
+	if pauseBehavior != "" {
+		if status != RecordingStatusPaused {
+			return nil, errors.New("PauseBehavior can only be set when pausing a recording")
+		}
+		if pauseBehavior != RecordingPauseBehaviorSkip && pauseBehavior != RecordingPauseBehaviorSilence {
+			return nil, fmt.Errorf("unsupported PauseBehavior %q", pauseBehavior)
+		}
+	}
+
+	formValues := url.Values{}
+	formValues.Set("Status", string(status))
+	if pauseBehavior != "" {
+		formValues.Set("PauseBehavior", pauseBehavior)
+	}
+	return formValues, nil
+}
+
+// GetRecording fetches the metadata of a recording.
+// See https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-resource
+func (twilio *Twilio) GetRecording(recordingSid string) (*Recording, *Exception, error) {
+	return twilio.GetRecordingWithContext(context.Background(), recordingSid)
+}
+
+func (twilio *Twilio) GetRecordingWithContext(ctx context.Context, recordingSid string) (*Recording, *Exception, error) {
+	res, err := twilio.get(ctx, twilio.buildUrl("Recordings/"+recordingSid+".json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		exception := new(Exception)
+		err = decoder.Decode(exception)
+		return nil, exception, err
+	}
+
+	recording := new(Recording)
+	err = decoder.Decode(recording)
+	return recording, nil, err
+}
+
+// ListRecordings returns an iterator over the recordings of the account
+// matching filter, most recent first.
+// See https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+func (twilio *Twilio) ListRecordings(filter *RecordingFilter, opts *PageOptions) RecordingIterator {
+	return twilio.ListRecordingsWithContext(context.Background(), filter, opts)
+}
+
+func (twilio *Twilio) ListRecordingsWithContext(ctx context.Context, filter *RecordingFilter, opts *PageOptions) RecordingIterator {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Recordings.json?" + filter.values().Encode()
+	return twilio.recordingIterator(ctx, twilioUrl, opts)
+}
+
+// ListCallRecordings returns an iterator over the recordings of a call.
+func (twilio *Twilio) ListCallRecordings(callSid string, opts *PageOptions) RecordingIterator {
+	return twilio.ListCallRecordingsWithContext(context.Background(), callSid, opts)
+}
+
+func (twilio *Twilio) ListCallRecordingsWithContext(ctx context.Context, callSid string, opts *PageOptions) RecordingIterator {
+	return twilio.recordingIterator(ctx, twilio.buildUrl("Calls/"+callSid+"/Recordings.json"), opts)
+}
+
+// ListConferenceRecordings returns an iterator over the recordings of a
+// conference.
+// See https://www.twilio.com/docs/voice/api/conference-recording-resource#read-multiple-recording-resources
+func (twilio *Twilio) ListConferenceRecordings(conferenceSid string, opts *PageOptions) RecordingIterator {
+	return twilio.ListConferenceRecordingsWithContext(context.Background(), conferenceSid, opts)
+}
+
+func (twilio *Twilio) ListConferenceRecordingsWithContext(ctx context.Context, conferenceSid string, opts *PageOptions) RecordingIterator {
+	return twilio.recordingIterator(ctx, twilio.buildUrl("Conferences/"+conferenceSid+"/Recordings.json"), opts)
+}
+
+func (twilio *Twilio) recordingIterator(ctx context.Context, twilioUrl string, opts *PageOptions) RecordingIterator {
+	return RecordingIterator{twilio.newIterator(ctx, twilioUrl, "recordings", opts, func() interface{} { return new(Recording) })}
+}
+
+// DownloadRecording streams the audio of a recording to w in the given
+// format and returns its content type.
+// See https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-media-file
+func (twilio *Twilio) DownloadRecording(recordingSid string, format RecordingFormat, w io.Writer) (string, *Exception, error) {
+	return twilio.DownloadRecordingWithContext(context.Background(), recordingSid, format, w)
+}
+
+func (twilio *Twilio) DownloadRecordingWithContext(ctx context.Context, recordingSid string, format RecordingFormat, w io.Writer) (string, *Exception, error) {
+	if format != RecordingFormatWAV && format != RecordingFormatMP3 {
+		return "", nil, fmt.Errorf("unsupported recording format %q", format)
+	}
+	return twilio.download(ctx, twilio.buildUrl("Recordings/"+recordingSid+"."+string(format)), w)
+}
+
+// DeleteRecording removes a recording and its audio.
+// See https://www.twilio.com/docs/voice/api/recording#delete-a-recording-resource
+func (twilio *Twilio) DeleteRecording(recordingSid string) (*Exception, error) {
+	return twilio.DeleteRecordingWithContext(context.Background(), recordingSid)
+}
+
+func (twilio *Twilio) DeleteRecordingWithContext(ctx context.Context, recordingSid string) (*Exception, error) {
+	res, err := twilio.delete(ctx, twilio.buildUrl("Recordings/"+recordingSid+".json"))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		exception := new(Exception)
+		err = json.NewDecoder(res.Body).Decode(exception)
+		return exception, err
+	}
+	return nil, nil
+}
+
+// recordingPost has the common bits for creating or updating a recording.
+func (twilio *Twilio) recordingPost(ctx context.Context, twilioUrl string, formValues url.Values) (*Recording, *Exception, error) {
+	res, err := twilio.post(ctx, formValues, twilioUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		exception := new(Exception)
+		err = decoder.Decode(exception)
+		return nil, exception, err
+	}
+
+	recording := new(Recording)
+	err = decoder.Decode(recording)
+	return recording, nil, err
+}
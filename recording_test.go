@@ -0,0 +1,99 @@
+package gotwilio
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPauseAndResumeCallRecording(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/Accounts/AC1/Calls/CA1/Recordings/Twilio.CURRENT.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprintf(w, `{"sid": "RE1", "call_sid": "CA1", "status": %q, "duration": "-1"}`, r.PostForm.Get("Status"))
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	rec, exc, err := twilio.UpdateCallRecording("CA1", CurrentRecording, RecordingStatusPaused, RecordingPauseBehaviorSkip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if rec.Status != RecordingStatusPaused {
+		t.Errorf("Unexpected recording: %+v", rec)
+	}
+
+	if rec, _, err = twilio.UpdateCallRecording("CA1", CurrentRecording, RecordingStatusInProgress, ""); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Status != RecordingStatusInProgress {
+		t.Errorf("Unexpected recording: %+v", rec)
+	}
+
+	if _, _, err = twilio.UpdateCallRecording("CA1", CurrentRecording, RecordingStatusStopped, RecordingPauseBehaviorSkip); err == nil {
+		t.Error("expected an error for PauseBehavior when stopping")
+	}
+	if _, _, err = twilio.UpdateCallRecording("CA1", CurrentRecording, RecordingStatusCompleted, ""); err == nil {
+		t.Error("expected an error for an unsupported status")
+	}
+}
+
+func TestListAndDownloadRecordings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/Accounts/AC1/Recordings.json":
+			if r.URL.Query().Get("CallSid") != "CA1" {
+				t.Errorf("Unexpected filters: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"recordings": [{"sid": "RE1", "duration": "7", "status": "completed"}], "next_page_uri": null}`)
+		case "/Accounts/AC1/Recordings/RE1.mp3":
+			w.Header().Set("Content-Type", "audio/mpeg")
+			fmt.Fprint(w, "ID3")
+		case "/Accounts/AC1/Recordings/RE1.json":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	it := twilio.ListRecordings(&RecordingFilter{CallSid: "CA1"}, nil)
+	var sids []string
+	for it.Next() {
+		sids = append(sids, it.Value().Sid)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sids) != 1 || sids[0] != "RE1" {
+		t.Errorf("Unexpected recordings: %v", sids)
+	}
+
+	var buf bytes.Buffer
+	contentType, exc, err := twilio.DownloadRecording("RE1", RecordingFormatMP3, &buf)
+	if err != nil || exc != nil {
+		t.Fatalf("Unexpected download failure: %v %v", exc, err)
+	}
+	if contentType != "audio/mpeg" || buf.String() != "ID3" {
+		t.Errorf("Unexpected download: %s %q", contentType, buf.String())
+	}
+	if _, _, err := twilio.DownloadRecording("RE1", "ogg", &buf); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+
+	if exc, err := twilio.DeleteRecording("RE1"); err != nil || exc != nil {
+		t.Fatalf("Unexpected delete failure: %v %v", exc, err)
+	}
+}
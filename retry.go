@@ -0,0 +1,164 @@
+package gotwilio
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// 429 (Too Many Requests) or a transient 5xx response.
+// See https://www.twilio.com/docs/usage/rest-api-best-practices#retry-failed-requests
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. It doubles on every
+	// following retry until MaxBackoff is reached.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. A Retry-After header sent
+	// by Twilio always takes precedence.
+	MaxBackoff time.Duration
+	// RetryPOST allows POST requests to be replayed. POSTs are not
+	// idempotent (retrying a SendSMS may deliver the message twice), so they
+	// are only retried when this is set or the request context was created
+	// with WithPOSTRetry.
+	RetryPOST bool
+}
+
+// NewRetryPolicy returns a RetryPolicy with sensible defaults: three attempts
+// with a backoff starting at 500ms and capped at 10s.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+	}
+}
+
+// WithRetryPolicy enables automatic retries on the client.
+func (twilio *Twilio) WithRetryPolicy(policy *RetryPolicy) *Twilio {
+	twilio.RetryPolicy = policy
+	return twilio
+}
+
+type postRetryKey struct{}
+
+// WithPOSTRetry returns a context that allows POST requests made with it to
+// be retried, regardless of RetryPolicy.RetryPOST. Only use it for requests
+// that are safe to send more than once.
+func WithPOSTRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, postRetryKey{}, true)
+}
+
+func (p *RetryPolicy) canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		optIn, _ := req.Context().Value(postRetryKey{}).(bool)
+		return p.RetryPOST || optIn
+	}
+	return false
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the given retry (starting at 1).
+func (p *RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Equal jitter: wait somewhere between half and all of the backoff so
+	// that concurrent clients don't retry in lockstep.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an
+// HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func (p *RetryPolicy) do(send DoFunc, req *http.Request) (*http.Response, error) {
+	if p.MaxAttempts <= 1 || !p.canRetry(req) {
+		return send(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := send(req)
+		if attempt >= p.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+		if err == nil && !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+
+		wait := p.backoff(attempt, resp)
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req = req.Clone(ctx)
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package gotwilio
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestRetryGet(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"code": 20429, "message": "Too Many Requests", "status": 429}`)
+			return
+		}
+		fmt.Fprintf(w, testUsageResponse)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("", "").WithRetryPolicy(testRetryPolicy())
+	twilio.BaseUrl = srv.URL
+
+	_, exc, err := twilio.GetUsage("", "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"message": "Service Unavailable", "status": 503}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("", "").WithRetryPolicy(testRetryPolicy())
+	twilio.BaseUrl = srv.URL
+
+	_, exc, err := twilio.GetUsage("", "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc == nil || exc.Status != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503 exception, got %v", exc)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryPOSTRequiresOptIn(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"code": 20429, "message": "Too Many Requests", "status": 429}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("", "").WithRetryPolicy(testRetryPolicy())
+	twilio.BaseUrl = srv.URL
+
+	_, exc, err := twilio.SendSMS("+15005550006", "+19135551234", "hi", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc == nil || exc.Code != ErrorTooManyRequests {
+		t.Fatalf("Expected 20429 exception, got %v", exc)
+	}
+	if len(bodies) != 1 {
+		t.Fatalf("Expected POST not to be retried, got %d attempts", len(bodies))
+	}
+
+	bodies = nil
+	_, _, err = twilio.SendSMSWithContext(WithPOSTRetry(context.Background()), "+15005550006", "+19135551234", "hi", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", len(bodies))
+	}
+	for _, b := range bodies {
+		if b != bodies[0] || b == "" {
+			t.Errorf("Expected every attempt to replay the same body, got %q", bodies)
+		}
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	wait, ok := retryAfter("2")
+	if !ok || wait != 2*time.Second {
+		t.Errorf("Expected 2s, got %v", wait)
+	}
+
+	p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+	for retry := 1; retry <= 5; retry++ {
+		if wait := p.backoff(retry, nil); wait > p.MaxBackoff {
+			t.Errorf("Backoff %v exceeds the maximum", wait)
+		}
+	}
+}
@@ -3,9 +3,11 @@ package gotwilio
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -112,6 +114,118 @@ var (
 	SmsContentRetentionRetain  = &Option{"ContentRetention", "retain"}
 )
 
+// ScheduleTypeFixed schedules a message to be sent at MessageRequest.SendAt.
+const ScheduleTypeFixed = "fixed"
+
+// maxMediaUrls is the number of media attachments Twilio accepts per message.
+const maxMediaUrls = 10
+
+// MessageRequest describes an outgoing message. Either From or
+// MessagingServiceSid must be set.
+// See https://www.twilio.com/docs/sms/api/message-resource#create-a-message-resource
+type MessageRequest struct {
+	To                  string   // Required
+	From                string   // Required unless MessagingServiceSid is set
+	MessagingServiceSid string   // Required unless From is set
+	Body                string   // Required unless MediaUrl is set
+	MediaUrl            []string // Optional, at most 10 URLs
+	StatusCallback      string   // Optional
+	ApplicationSid      string   // Optional
+
+	// Scheduling is only available when sending through a Messaging Service.
+	ScheduleType string    // Optional, ScheduleTypeFixed
+	SendAt       time.Time // Required when ScheduleType is set
+
+	ValidityPeriod   int      // Optional, in seconds
+	MaxPrice         string   // Optional
+	ProvideFeedback  *bool    // Optional
+	Attempt          int      // Optional
+	SmartEncoded     *bool    // Optional
+	ShortenUrls      *bool    // Optional, requires a Messaging Service
+	PersistentAction []string // Optional
+	AddressRetention string   // Optional, "retain" or "obfuscate"
+	ContentRetention string   // Optional, "retain" or "discard"
+}
+
+// Validate checks the request for combinations of parameters that Twilio
+// would reject.
+func (r *MessageRequest) Validate() error {
+	if r.To == "" {
+		return errors.New("To is required")
+	}
+	if r.From == "" && r.MessagingServiceSid == "" {
+		return errors.New("either From or MessagingServiceSid is required")
+	}
+	if r.Body == "" && len(r.MediaUrl) == 0 {
+		return errors.New("either Body or MediaUrl is required")
+	}
+	if len(r.MediaUrl) > maxMediaUrls {
+		return errors.New("a message can have at most 10 MediaUrl")
+	}
+	if r.ScheduleType != "" || !r.SendAt.IsZero() {
+		if r.ScheduleType != ScheduleTypeFixed {
+			return errors.New("ScheduleType must be fixed when SendAt is set")
+		}
+		if r.SendAt.IsZero() {
+			return errors.New("SendAt is required when ScheduleType is set")
+		}
+		if r.MessagingServiceSid == "" {
+			return errors.New("scheduled messages must be sent with a MessagingServiceSid")
+		}
+	}
+	if r.ShortenUrls != nil && *r.ShortenUrls && r.MessagingServiceSid == "" {
+		return errors.New("ShortenUrls requires a MessagingServiceSid")
+	}
+	if r.ValidityPeriod < 0 || r.Attempt < 0 {
+		return errors.New("ValidityPeriod and Attempt can't be negative")
+	}
+	return nil
+}
+
+func (r *MessageRequest) formValues() url.Values {
+	formValues := initFormValues(r.To, r.Body, r.MediaUrl, r.StatusCallback, r.ApplicationSid)
+
+	if r.From != "" {
+		formValues.Set("From", r.From)
+	}
+	if r.MessagingServiceSid != "" {
+		formValues.Set("MessagingServiceSid", r.MessagingServiceSid)
+	}
+	if r.ScheduleType != "" {
+		formValues.Set("ScheduleType", r.ScheduleType)
+		formValues.Set("SendAt", r.SendAt.UTC().Format(time.RFC3339))
+	}
+	if r.ValidityPeriod != 0 {
+		formValues.Set("ValidityPeriod", strconv.Itoa(r.ValidityPeriod))
+	}
+	if r.MaxPrice != "" {
+		formValues.Set("MaxPrice", r.MaxPrice)
+	}
+	if r.ProvideFeedback != nil {
+		formValues.Set("ProvideFeedback", strconv.FormatBool(*r.ProvideFeedback))
+	}
+	if r.Attempt != 0 {
+		formValues.Set("Attempt", strconv.Itoa(r.Attempt))
+	}
+	if r.SmartEncoded != nil {
+		formValues.Set("SmartEncoded", strconv.FormatBool(*r.SmartEncoded))
+	}
+	if r.ShortenUrls != nil {
+		formValues.Set("ShortenUrls", strconv.FormatBool(*r.ShortenUrls))
+	}
+	for _, action := range r.PersistentAction {
+		formValues.Add("PersistentAction", action)
+	}
+	if r.AddressRetention != "" {
+		formValues.Set("AddressRetention", r.AddressRetention)
+	}
+	if r.ContentRetention != "" {
+		formValues.Set("ContentRetention", r.ContentRetention)
+	}
+
+	return formValues
+}
+
 // DateCreatedAsTime returns SmsResponse.DateCreated as a time.Time object
 // instead of a string.
 func (sms *SmsResponse) DateCreatedAsTime() (time.Time, error) {
@@ -153,6 +267,22 @@ func (twilio *Twilio) SendWhatsAppMediaWithContext(ctx context.Context, from, to
 	return twilio.sendMessage(ctx, formValues)
 }
 
+// SendMessage uses Twilio to send an SMS, MMS or WhatsApp message described by
+// req. It supports every option of the Message resource, including
+// scheduled sending through a Messaging Service.
+// See https://www.twilio.com/docs/sms/api/message-resource#create-a-message-resource
+func (twilio *Twilio) SendMessage(req *MessageRequest) (smsResponse *SmsResponse, exception *Exception, err error) {
+	return twilio.SendMessageWithContext(context.Background(), req)
+}
+
+func (twilio *Twilio) SendMessageWithContext(ctx context.Context, req *MessageRequest) (smsResponse *SmsResponse, exception *Exception, err error) {
+	if err = req.Validate(); err != nil {
+		return smsResponse, exception, err
+	}
+
+	return twilio.sendMessage(ctx, req.formValues())
+}
+
 // SendSMS uses Twilio to send a text message.
 // See http://www.twilio.com/docs/api/rest/sending-sms for more information.
 func (twilio *Twilio) SendSMS(from, to, body, statusCallback, applicationSid string, opts ...*Option) (smsResponse *SmsResponse, exception *Exception, err error) {
@@ -253,6 +383,51 @@ func (twilio *Twilio) GetMessageWithContext(ctx context.Context, sid string) (me
 	return messageResponse, exception, err
 }
 
+// MessageFilter narrows down the messages returned by ListMessages.
+// Zero values are ignored.
+type MessageFilter struct {
+	To             string
+	From           string
+	DateSent       time.Time // only messages sent on this day (UTC)
+	DateSentBefore time.Time // only messages sent on or before this time
+	DateSentAfter  time.Time // only messages sent on or after this time
+}
+
+func (f *MessageFilter) values() url.Values {
+	values := url.Values{}
+	if f == nil {
+		return values
+	}
+	if f.To != "" {
+		values.Set("To", f.To)
+	}
+	if f.From != "" {
+		values.Set("From", f.From)
+	}
+	if !f.DateSent.IsZero() {
+		values.Set("DateSent", f.DateSent.UTC().Format("2006-01-02"))
+	}
+	if !f.DateSentBefore.IsZero() {
+		values.Set("DateSent<", f.DateSentBefore.UTC().Format(time.RFC3339))
+	}
+	if !f.DateSentAfter.IsZero() {
+		values.Set("DateSent>", f.DateSentAfter.UTC().Format(time.RFC3339))
+	}
+	return values
+}
+
+// ListMessages returns an iterator over the messages of the account matching
+// filter, most recent first. Pages are fetched as they are needed.
+// See https://www.twilio.com/docs/sms/api/message-resource#read-multiple-message-resources
+func (twilio *Twilio) ListMessages(filter *MessageFilter, opts *PageOptions) MessageIterator {
+	return twilio.ListMessagesWithContext(context.Background(), filter, opts)
+}
+
+func (twilio *Twilio) ListMessagesWithContext(ctx context.Context, filter *MessageFilter, opts *PageOptions) MessageIterator {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Messages.json?" + filter.values().Encode()
+	return MessageIterator{twilio.newIterator(ctx, twilioUrl, "messages", opts, func() interface{} { return new(MessageResponse) })}
+}
+
 // SendSMSWithCopilot uses Twilio Copilot to send a text message.
 // See https://www.twilio.com/docs/api/rest/sending-messages-copilot
 func (twilio *Twilio) SendSMSWithCopilot(messagingServiceSid, to, body, statusCallback, applicationSid string) (smsResponse *SmsResponse, exception *Exception, err error) {
@@ -377,10 +552,98 @@ func (twilio *Twilio) SendMMSWithCopilotWithContext(ctx context.Context, messagi
 	return
 }
 
+// RedactMessage removes the body of a message by updating it to an empty
+// string. The message itself is kept in the message log.
+// See https://www.twilio.com/docs/sms/api/message-resource#update-a-message-resource
+func (twilio *Twilio) RedactMessage(sid string) (*MessageResponse, *Exception, error) {
+	return twilio.RedactMessageWithContext(context.Background(), sid)
+}
+
+func (twilio *Twilio) RedactMessageWithContext(ctx context.Context, sid string) (*MessageResponse, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("Body", "")
+
+	return twilio.updateMessage(ctx, sid, formValues)
+}
+
+// CancelMessage cancels a scheduled message before it is sent.
+// See https://www.twilio.com/docs/messaging/features/message-scheduling#cancel-a-scheduled-message
+func (twilio *Twilio) CancelMessage(sid string) (*MessageResponse, *Exception, error) {
+	return twilio.CancelMessageWithContext(context.Background(), sid)
+}
+
+func (twilio *Twilio) CancelMessageWithContext(ctx context.Context, sid string) (*MessageResponse, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("Status", "canceled")
+
+	return twilio.updateMessage(ctx, sid, formValues)
+}
+
+// DeleteMessage removes a message and its media from the account.
+// See https://www.twilio.com/docs/sms/api/message-resource#delete-a-message-resource
+func (twilio *Twilio) DeleteMessage(sid string) (*Exception, error) {
+	return twilio.DeleteMessageWithContext(context.Background(), sid)
+}
+
+func (twilio *Twilio) DeleteMessageWithContext(ctx context.Context, sid string) (*Exception, error) {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Messages/" + sid + ".json"
+
+	res, err := twilio.delete(ctx, twilioUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		exception := new(Exception)
+		err = json.NewDecoder(res.Body).Decode(exception)
+		return exception, err
+	}
+	return nil, nil
+}
+
+func (twilio *Twilio) updateMessage(ctx context.Context, sid string, formValues url.Values) (messageResponse *MessageResponse, exception *Exception, err error) {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Messages/" + sid + ".json"
+
+	res, err := twilio.post(ctx, formValues, twilioUrl)
+	if err != nil {
+		return messageResponse, exception, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return messageResponse, exception, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		exception = new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+
+		// We aren't checking the error because we don't actually care.
+		// It's going to be passed to the client either way.
+		return messageResponse, exception, err
+	}
+
+	messageResponse = new(MessageResponse)
+	err = json.Unmarshal(responseBody, messageResponse)
+	return messageResponse, exception, err
+}
+
 // Core method to send message
 func (twilio *Twilio) sendMessage(ctx context.Context, formValues url.Values) (smsResponse *SmsResponse, exception *Exception, err error) {
 	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Messages.json"
 
+	if twilio.RateLimiter != nil {
+		sender := formValues.Get("MessagingServiceSid")
+		if sender == "" {
+			sender = formValues.Get("From")
+		}
+		if err = twilio.RateLimiter.Wait(ctx, twilio.AccountSid, sender); err != nil {
+			return smsResponse, exception, err
+		}
+	}
+
 	res, err := twilio.post(ctx, formValues, twilioUrl)
 	if err != nil {
 		return smsResponse, exception, err
@@ -0,0 +1,181 @@
+package gotwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListMessages(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("To") != "+19135551234" || q.Get("DateSent>") != "2020-01-01T00:00:00Z" {
+			t.Errorf("Unexpected filters: %s", r.URL.RawQuery)
+		}
+		if r.URL.Path != "/Accounts/AC1/Messages.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, testListMessagesResponse)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	it := twilio.ListMessages(&MessageFilter{
+		To:            "+19135551234",
+		DateSentAfter: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	var bodies []string
+	for it.Next() {
+		bodies = append(bodies, it.Value().Body)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 2 || bodies[0] != "Hello" {
+		t.Errorf("Unexpected messages: %v", bodies)
+	}
+}
+
+const testListMessagesResponse = `
+{
+  "end": 1,
+  "first_page_uri": "/Accounts/AC1/Messages.json?To=%2B19135551234&PageSize=50&Page=0",
+  "next_page_uri": null,
+  "page": 0,
+  "page_size": 50,
+  "previous_page_uri": null,
+  "messages": [
+    {
+      "account_sid": "AC1",
+      "body": "Hello",
+      "direction": "outbound-api",
+      "from": "+15005550006",
+      "sid": "SM1",
+      "status": "delivered",
+      "to": "+19135551234"
+    },
+    {
+      "account_sid": "AC1",
+      "body": "World",
+      "direction": "outbound-api",
+      "from": "+15005550006",
+      "sid": "SM2",
+      "status": "delivered",
+      "to": "+19135551234"
+    }
+  ],
+  "start": 0,
+  "uri": "/Accounts/AC1/Messages.json?To=%2B19135551234&PageSize=50&Page=0"
+}
+`
+
+func TestRedactAndDeleteMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			r.ParseForm()
+			if _, ok := r.PostForm["Body"]; !ok || r.PostForm.Get("Body") != "" {
+				t.Errorf("Expected an empty Body, got %v", r.PostForm)
+			}
+			fmt.Fprint(w, `{"sid": "SM1", "body": ""}`)
+		case http.MethodDelete:
+			if r.URL.Path == "/Accounts/AC1/Messages/SM404.json" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"code": 20404, "message": "The requested resource was not found", "status": 404}`)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	msg, exc, err := twilio.RedactMessage("SM1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if msg.Sid != "SM1" || msg.Body != "" {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+
+	if exc, err = twilio.DeleteMessage("SM1"); err != nil || exc != nil {
+		t.Fatalf("Unexpected delete failure: %v %v", exc, err)
+	}
+
+	exc, err = twilio.DeleteMessage("SM404")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc == nil || exc.Code != ErrorResourceNotFound {
+		t.Fatalf("Expected not found exception, got %v", exc)
+	}
+}
+
+func TestMessageRequestValidate(t *testing.T) {
+	sendAt := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		req   MessageRequest
+		valid bool
+	}{
+		{"basic", MessageRequest{To: "+19135551234", From: "+15005550006", Body: "hi"}, true},
+		{"no sender", MessageRequest{To: "+19135551234", Body: "hi"}, false},
+		{"no content", MessageRequest{To: "+19135551234", From: "+15005550006"}, false},
+		{"scheduled", MessageRequest{To: "+19135551234", MessagingServiceSid: "MG1", Body: "hi", ScheduleType: ScheduleTypeFixed, SendAt: sendAt}, true},
+		{"scheduled without service", MessageRequest{To: "+19135551234", From: "+15005550006", Body: "hi", ScheduleType: ScheduleTypeFixed, SendAt: sendAt}, false},
+		{"scheduled without time", MessageRequest{To: "+19135551234", MessagingServiceSid: "MG1", Body: "hi", ScheduleType: ScheduleTypeFixed}, false},
+		{"too much media", MessageRequest{To: "+19135551234", From: "+15005550006", MediaUrl: make([]string, 11)}, false},
+	}
+	for _, test := range tests {
+		err := test.req.Validate()
+		if (err == nil) != test.valid {
+			t.Errorf("%s: expected valid=%t, got %v", test.name, test.valid, err)
+		}
+	}
+}
+
+func TestSendMessageScheduled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("ScheduleType") != "fixed" || r.PostForm.Get("SendAt") != "2030-01-01T12:00:00Z" {
+			t.Errorf("Unexpected scheduling parameters: %v", r.PostForm)
+		}
+		if r.PostForm.Get("MessagingServiceSid") != "MG1" || r.PostForm.Get("SmartEncoded") != "true" {
+			t.Errorf("Unexpected parameters: %v", r.PostForm)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"sid": "SM1", "status": "scheduled"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	res, exc, err := twilio.SendMessage(&MessageRequest{
+		To:                  "+19135551234",
+		MessagingServiceSid: "MG1",
+		Body:                "Reminder",
+		ScheduleType:        ScheduleTypeFixed,
+		SendAt:              time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC),
+		SmartEncoded:        NewBoolean(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if res.Status != "scheduled" {
+		t.Errorf("Expected scheduled status, got %s", res.Status)
+	}
+}
@@ -0,0 +1,98 @@
+package gotwilio
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// FaxResponse is the TwiML answering an IncomingFaxWebhook: a fax is only
+// received if the response contains Receive.
+// See https://www.twilio.com/docs/fax/twiml
+type FaxResponse struct {
+	XMLName     xml.Name      `xml:"Response"`
+	ReceiveVerb *TWiMLReceive `xml:"Receive,omitempty"`
+	RejectVerb  *TWiMLReject  `xml:"Reject,omitempty"`
+}
+
+// TWiMLReceive receives an incoming fax.
+// See https://www.twilio.com/docs/fax/twiml/receive
+type TWiMLReceive struct {
+	XMLName    xml.Name   `xml:"Receive"`
+	Action     string     `xml:"action,attr,omitempty"`
+	Method     string     `xml:"method,attr,omitempty"`
+	MediaType  string     `xml:"mediaType,attr,omitempty"`
+	PageSize   string     `xml:"pageSize,attr,omitempty"`
+	StoreMedia *bool      `xml:"storeMedia,attr,omitempty"`
+	Attrs      []xml.Attr `xml:",any,attr"`
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLReceive) Validate() error {
+	switch v.Method {
+	case "", http.MethodGet, http.MethodPost:
+	default:
+		return fmt.Errorf("unsupported Receive method %q", v.Method)
+	}
+	switch v.MediaType {
+	case "", "application/pdf", "image/tiff":
+	default:
+		return fmt.Errorf("unsupported Receive mediaType %q", v.MediaType)
+	}
+	switch v.PageSize {
+	case "", "letter", "legal", "a4":
+	default:
+		return fmt.Errorf("unsupported Receive pageSize %q", v.PageSize)
+	}
+	return nil
+}
+
+// Receive answers the fax with receive.
+func (r *FaxResponse) Receive(receive *TWiMLReceive) (*FaxResponse, error) {
+	if r.ReceiveVerb != nil || r.RejectVerb != nil {
+		return r, errors.New("a fax response can only contain one verb")
+	}
+	if err := receive.Validate(); err != nil {
+		return r, err
+	}
+	r.ReceiveVerb = receive
+	return r, nil
+}
+
+// Reject refuses the fax.
+func (r *FaxResponse) Reject() (*FaxResponse, error) {
+	if r.ReceiveVerb != nil || r.RejectVerb != nil {
+		return r, errors.New("a fax response can only contain one verb")
+	}
+	r.RejectVerb = &TWiMLReject{}
+	return r, nil
+}
+
+// Validate checks the response against the TwiML schema.
+func (r *FaxResponse) Validate() error {
+	if (r.ReceiveVerb == nil) == (r.RejectVerb == nil) {
+		return errors.New("a fax response requires exactly one of Receive and Reject")
+	}
+	if r.ReceiveVerb != nil {
+		return r.ReceiveVerb.Validate()
+	}
+	if r.RejectVerb.Reason != "" {
+		return errors.New("Reject has no reason for faxes")
+	}
+	return nil
+}
+
+// RenderTwiML implements TwiMLRenderer so a FaxResponse can be returned from
+// a WebhookHandler callback.
+func (r *FaxResponse) RenderTwiML() (string, error) {
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+
+	output, err := xml.MarshalIndent(r, "  ", "   ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(output), nil
+}
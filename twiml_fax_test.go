@@ -0,0 +1,27 @@
+package gotwilio
+
+import "testing"
+
+func TestFaxResponseValidate(t *testing.T) {
+	if _, err := new(FaxResponse).Receive(&TWiMLReceive{PageSize: "a3"}); err == nil {
+		t.Error("expected an error for an unsupported page size")
+	}
+	r, err := new(FaxResponse).Reject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Receive(&TWiMLReceive{}); err == nil {
+		t.Error("expected an error for a second verb")
+	}
+	if _, err := new(FaxResponse).RenderTwiML(); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+
+	twiml, err := r.RenderTwiML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n  <Response>\n     <Reject></Reject>\n  </Response>"; twiml != want {
+		t.Errorf("Unexpected TwiML:\n%s", twiml)
+	}
+}
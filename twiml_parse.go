@@ -0,0 +1,470 @@
+package gotwilio
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ParseMessagingResponse reads a TwiML messaging document back into a
+// MessagingResponse. Attributes the structs don't model are kept in Attrs.
+// Elements that aren't allowed by the TwiML schema, such as verbs nested
+// inside Message, and verbs that fail validation are reported as errors.
+func ParseMessagingResponse(twiml string) (*MessagingResponse, error) {
+	r := new(MessagingResponse)
+	if err := xml.Unmarshal([]byte(twiml), r); err != nil {
+		return nil, err
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ParseVoiceTwiML reads a TwiML voice document back into a VoiceTwiML.
+// Attributes the structs don't model are kept in Attrs and SSML markup in
+// Say is kept in TWiMLSay.SSML. Unknown elements, elements nested where the
+// TwiML schema doesn't allow them and verbs that fail validation are reported
+// as errors.
+func ParseVoiceTwiML(twiml string) (*VoiceTwiML, error) {
+	r := new(VoiceTwiML)
+	if err := xml.Unmarshal([]byte(twiml), r); err != nil {
+		return nil, err
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+var twimlVoiceVerbs = map[string]func() TWiMLVoiceVerb{
+	"Say":      func() TWiMLVoiceVerb { return new(TWiMLSay) },
+	"Play":     func() TWiMLVoiceVerb { return new(TWiMLPlay) },
+	"Pause":    func() TWiMLVoiceVerb { return new(TWiMLPause) },
+	"Gather":   func() TWiMLVoiceVerb { return new(TWiMLGather) },
+	"Dial":     func() TWiMLVoiceVerb { return new(TWiMLDial) },
+	"Record":   func() TWiMLVoiceVerb { return new(TWiMLRecord) },
+	"Enqueue":  func() TWiMLVoiceVerb { return new(TWiMLEnqueue) },
+	"Hangup":   func() TWiMLVoiceVerb { return new(TWiMLHangup) },
+	"Redirect": func() TWiMLVoiceVerb { return new(TWiMLRedirect) },
+	"Reject":   func() TWiMLVoiceVerb { return new(TWiMLReject) },
+	"Leave":    func() TWiMLVoiceVerb { return new(TWiMLLeave) },
+	"Connect":  func() TWiMLVoiceVerb { return new(TWiMLConnect) },
+}
+
+var twimlGatherNested = map[string]func() TWiMLGatherNested{
+	"Say":   func() TWiMLGatherNested { return new(TWiMLSay) },
+	"Play":  func() TWiMLGatherNested { return new(TWiMLPlay) },
+	"Pause": func() TWiMLGatherNested { return new(TWiMLPause) },
+}
+
+var twimlDialNouns = map[string]func() TWiMLDialNoun{
+	"Number":     func() TWiMLDialNoun { return new(TWiMLNumber) },
+	"Client":     func() TWiMLDialNoun { return new(TWiMLClient) },
+	"Sip":        func() TWiMLDialNoun { return new(TWiMLSip) },
+	"Conference": func() TWiMLDialNoun { return new(TWiMLConference) },
+	"Queue":      func() TWiMLDialNoun { return new(TWiMLQueue) },
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (r *VoiceTwiML) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "Response" {
+		return fmt.Errorf("expected <Response>, got <%s>", start.Name.Local)
+	}
+	r.XMLName = start.Name
+
+	text, err := decodeTWiMLElement(d, start, nil, func(child xml.StartElement) error {
+		newVerb, ok := twimlVoiceVerbs[child.Name.Local]
+		if !ok {
+			return fmt.Errorf("Response can't contain <%s>", child.Name.Local)
+		}
+		verb := newVerb()
+		if err := d.DecodeElement(verb, &child); err != nil {
+			return err
+		}
+		r.Verbs = append(r.Verbs, verb)
+		return nil
+	})
+	if err == nil && text != "" {
+		err = fmt.Errorf("Response can't contain text %q", text)
+	}
+	return err
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLGather) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLGather
+	text, err := decodeTWiMLElement(d, start, (*attrs)(v), func(child xml.StartElement) error {
+		newNested, ok := twimlGatherNested[child.Name.Local]
+		if !ok {
+			return fmt.Errorf("Gather can't contain <%s>", child.Name.Local)
+		}
+		nested := newNested()
+		if err := d.DecodeElement(nested, &child); err != nil {
+			return err
+		}
+		v.Nested = append(v.Nested, nested)
+		return nil
+	})
+	if err == nil && text != "" {
+		err = fmt.Errorf("Gather can't contain text %q", text)
+	}
+	return err
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLDial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLDial
+	text, err := decodeTWiMLElement(d, start, (*attrs)(v), func(child xml.StartElement) error {
+		newNoun, ok := twimlDialNouns[child.Name.Local]
+		if !ok {
+			return fmt.Errorf("Dial can't contain <%s>", child.Name.Local)
+		}
+		noun := newNoun()
+		if err := d.DecodeElement(noun, &child); err != nil {
+			return err
+		}
+		v.Nouns = append(v.Nouns, noun)
+		return nil
+	})
+	v.Number = text
+	return err
+}
+
+// UnmarshalXML implements xml.Unmarshaler. Only one of Text and SSML is set:
+// SSML if the content contains markup, Text otherwise.
+func (v *TWiMLSay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLSay
+	if err := d.DecodeElement((*attrs)(v), &start); err != nil {
+		return err
+	}
+	v.Attrs = twimlPrefixedAttrs(v.Attrs)
+	if strings.Contains(v.SSML, "<") {
+		v.Text = ""
+	} else {
+		v.SSML = ""
+	}
+	return nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLPlay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLPlay
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.URL)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLPause) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLPause
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLNumber) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLNumber
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.Number)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLClient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLClient
+	var identity string
+	text, err := decodeTWiMLElement(d, start, (*attrs)(v), func(child xml.StartElement) error {
+		switch child.Name.Local {
+		case "Identity":
+			if identity != "" {
+				return errors.New("Client can't contain several <Identity>")
+			}
+			return decodeTWiMLText(d, child, &identity)
+		case "Parameter":
+			var param TWiMLParameter
+			if err := d.DecodeElement(&param, &child); err != nil {
+				return err
+			}
+			v.Parameters = append(v.Parameters, param)
+			return nil
+		}
+		return fmt.Errorf("Client can't contain <%s>", child.Name.Local)
+	})
+	if err != nil {
+		return err
+	}
+	if text != "" && identity != "" {
+		return errors.New("Client can't contain both text and <Identity>")
+	}
+	v.Identity = text + identity
+	return nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLSip) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLSip
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.URI)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLConference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLConference
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.Name)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLQueue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLQueue
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.Name)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLRecord) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLRecord
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLEnqueue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLEnqueue
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.Name)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLHangup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLHangup
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLRedirect) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLRedirect
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.URL)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLReject) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLReject
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLLeave) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLLeave
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLConnect) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLConnect
+	text, err := decodeTWiMLElement(d, start, (*attrs)(v), func(child xml.StartElement) error {
+		if child.Name.Local != "Stream" || v.Stream != nil {
+			return fmt.Errorf("Connect can't contain <%s>", child.Name.Local)
+		}
+		v.Stream = new(TWiMLStream)
+		return d.DecodeElement(v.Stream, &child)
+	})
+	if err == nil && text != "" {
+		err = fmt.Errorf("Connect can't contain text %q", text)
+	}
+	return err
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLStream) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLStream
+	text, err := decodeTWiMLElement(d, start, (*attrs)(v), func(child xml.StartElement) error {
+		if child.Name.Local != "Parameter" {
+			return fmt.Errorf("Stream can't contain <%s>", child.Name.Local)
+		}
+		var param TWiMLParameter
+		if err := d.DecodeElement(&param, &child); err != nil {
+			return err
+		}
+		v.Parameters = append(v.Parameters, param)
+		return nil
+	})
+	if err == nil && text != "" {
+		err = fmt.Errorf("Stream can't contain text %q", text)
+	}
+	return err
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLParameter
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (r *MessagingResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "Response" {
+		return fmt.Errorf("expected <Response>, got <%s>", start.Name.Local)
+	}
+	r.XMLName = start.Name
+
+	text, err := decodeTWiMLElement(d, start, nil, func(child xml.StartElement) error {
+		if r.RedirectVerb != nil {
+			return fmt.Errorf("Response can't contain <%s> after Redirect", child.Name.Local)
+		}
+		switch child.Name.Local {
+		case "Message":
+		case "Redirect":
+			r.RedirectVerb = new(TWiMLSmsRedirect)
+			return d.DecodeElement(r.RedirectVerb, &child)
+		default:
+			return fmt.Errorf("Response can't contain <%s>", child.Name.Local)
+		}
+		msg := new(TWiMLSmsMessage)
+		if err := d.DecodeElement(msg, &child); err != nil {
+			return err
+		}
+		r.Messages = append(r.Messages, msg)
+		return nil
+	})
+	if err == nil && text != "" {
+		err = fmt.Errorf("Response can't contain text %q", text)
+	}
+	return err
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (m *TWiMLSmsMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLSmsMessage
+	text, err := decodeTWiMLElement(d, start, (*attrs)(m), func(child xml.StartElement) error {
+		var noun *string
+		switch child.Name.Local {
+		case "Body":
+			noun = new(string)
+			m.Body = noun
+		case "Media":
+			var url string
+			if err := decodeTWiMLText(d, child, &url); err != nil {
+				return err
+			}
+			if m.Media == nil {
+				m.Media = &url
+			} else {
+				m.MediaUrls = append(m.MediaUrls, url)
+			}
+			return nil
+		case "Redirect":
+			noun = new(string)
+			m.Redirect = noun
+		default:
+			return fmt.Errorf("Message can't contain <%s>", child.Name.Local)
+		}
+		return decodeTWiMLText(d, child, noun)
+	})
+	if err != nil {
+		return err
+	}
+	m.Message = text
+	return m.Validate()
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (v *TWiMLSmsRedirect) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type attrs TWiMLSmsRedirect
+	return decodeTWiMLLeaf(d, start, (*attrs)(v), &v.URL)
+}
+
+// decodeTWiMLText decodes an element that may only contain text.
+func decodeTWiMLText(d *xml.Decoder, start xml.StartElement, text *string) error {
+	return decodeTWiMLLeaf(d, start, nil, text)
+}
+
+// decodeTWiMLLeaf decodes an element that can't contain other elements. Its
+// text is stored in text, or reported as an error if text is nil.
+func decodeTWiMLLeaf(d *xml.Decoder, start xml.StartElement, attrs interface{}, text *string) error {
+	content, err := decodeTWiMLElement(d, start, attrs, func(child xml.StartElement) error {
+		return fmt.Errorf("%s can't contain <%s>", start.Name.Local, child.Name.Local)
+	})
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		if content != "" {
+			return fmt.Errorf("%s can't contain text %q", start.Name.Local, content)
+		}
+		return nil
+	}
+	*text = content
+	return nil
+}
+
+// twimlPrefixedAttrs returns attrs with the namespace declarations and the
+// attributes using them named as they were written, e.g. "xmlns:x" and
+// "x:foo", as encoding/xml can't encode them back from their namespace.
+// Attributes using a namespace declared on an ancestor keep their namespace,
+// encoding/xml declares a prefix for it on the element.
+func twimlPrefixedAttrs(attrs []xml.Attr) []xml.Attr {
+	if len(attrs) == 0 {
+		return attrs
+	}
+
+	prefixes := make(map[string]string)
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			prefixes[attr.Value] = attr.Name.Local
+		}
+	}
+
+	prefixed := make([]xml.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			attr.Name = xml.Name{Local: "xmlns:" + attr.Name.Local}
+		} else if prefix, ok := prefixes[attr.Name.Space]; ok {
+			attr.Name = xml.Name{Local: prefix + ":" + attr.Name.Local}
+		}
+		prefixed = append(prefixed, attr)
+	}
+	return prefixed
+}
+
+// decodeTWiMLElement decodes the attributes of start into attrs, if not nil,
+// and calls child for every element nested in start. It returns the text
+// directly contained in start with surrounding whitespace removed. The
+// attributes stored in the Attrs field of attrs keep their prefixes.
+//
+// attrs must not implement xml.Unmarshaler, callers pass a defined type based
+// on their own to decode the attributes with the struct tags.
+func decodeTWiMLElement(d *xml.Decoder, start xml.StartElement, attrs interface{}, child func(xml.StartElement) error) (string, error) {
+	if attrs != nil {
+		var buf bytes.Buffer
+		enc := xml.NewEncoder(&buf)
+		empty := xml.StartElement{Name: xml.Name{Local: start.Name.Local}, Attr: twimlPrefixedAttrs(start.Attr)}
+		if err := enc.EncodeToken(empty); err != nil {
+			return "", err
+		}
+		if err := enc.EncodeToken(empty.End()); err != nil {
+			return "", err
+		}
+		if err := enc.Flush(); err != nil {
+			return "", err
+		}
+		if err := xml.Unmarshal(buf.Bytes(), attrs); err != nil {
+			return "", err
+		}
+		if field := reflect.ValueOf(attrs).Elem().FieldByName("Attrs"); field.IsValid() {
+			field.Set(reflect.ValueOf(twimlPrefixedAttrs(field.Interface().([]xml.Attr))))
+		}
+	}
+
+	var text strings.Builder
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if err := child(t); err != nil {
+				return "", err
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			return strings.TrimSpace(text.String()), nil
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package gotwilio
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestParseVoiceTwiMLRoundTrip(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	doc := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Gather input="dtmf" action="/menu" numDigits="1" customAttr="x"> <Say voice="alice" loop="2">Press 1 for sales.</Say> <Pause length="1"></Pause> </Gather> <Dial callerId="+15005550006"> <Number sendDigits="1">+19135551234</Number> <Conference>room</Conference> </Dial> </Response>`
+	// Conference can't be mixed with other nouns, fix the document first.
+	if _, err := ParseVoiceTwiML(doc); err == nil {
+		t.Fatal("expected a nesting error")
+	}
+	doc = strings.Replace(doc, " <Conference>room</Conference>", "", 1)
+
+	vr, err := ParseVoiceTwiML(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gather, ok := vr.Verbs[0].(*TWiMLGather)
+	if !ok {
+		t.Fatalf("expected Gather, got %T", vr.Verbs[0])
+	}
+	if gather.NumDigits != 1 || len(gather.Nested) != 2 {
+		t.Errorf("unexpected Gather: %+v", gather)
+	}
+	if len(gather.Attrs) != 1 || gather.Attrs[0].Name.Local != "customAttr" {
+		t.Errorf("unknown attribute was not preserved: %+v", gather.Attrs)
+	}
+	if say := gather.Nested[0].(*TWiMLSay); *say.Loop != 2 || say.Text != "Press 1 for sales." {
+		t.Errorf("unexpected Say: %+v", say)
+	}
+
+	xml, err := vr.TWiMLVoiceRender()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := space.ReplaceAllString(xml, " "); got != doc {
+		t.Errorf("document did not round-trip:\n%s\n%s", doc, got)
+	}
+}
+
+func TestParseTwiMLNamespacedAttrs(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	doc := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Say xmlns:ext="https://example.com/ext" ext:id="greeting" xml:lang="en-US">Hello</Say> <Gather numDigits="1" xmlns:x="urn:x" x:step="menu"> <Play xmlns:x="urn:x" x:cache="no">https://example.com/menu.mp3</Play> </Gather> </Response>`
+
+	vr, err := ParseVoiceTwiML(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml, err := vr.TWiMLVoiceRender()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := space.ReplaceAllString(xml, " "); got != doc {
+		t.Errorf("document did not round-trip:\n%s\n%s", doc, got)
+	}
+
+	mr, err := ParseMessagingResponse(`<Response><Redirect xmlns:x="urn:x" x:step="next">/next</Redirect></Response>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attrs := mr.RedirectVerb.Attrs; len(attrs) != 2 || attrs[1].Name.Local != "x:step" {
+		t.Errorf("unexpected Redirect attributes: %+v", attrs)
+	}
+}
+
+func TestParseVoiceTwiMLClientIdentity(t *testing.T) {
+	vr, err := ParseVoiceTwiML(`<Response><Dial><Client><Identity>bob</Identity><Parameter name="ticket" value="42"/></Client></Dial></Response>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := vr.Verbs[0].(*TWiMLDial).Nouns[0].(*TWiMLClient)
+	if client.Identity != "bob" || len(client.Parameters) != 1 || client.Parameters[0].Value != "42" {
+		t.Errorf("unexpected Client: %+v", client)
+	}
+	if _, err := ParseVoiceTwiML(`<Response><Dial><Client>alice<Identity>bob</Identity></Client></Dial></Response>`); err == nil {
+		t.Error("expected an error for two identities")
+	}
+}
+
+func TestParseVoiceTwiMLSSML(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	doc := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Say voice="Polly.Joanna">Hello <break time="1s"/> <prosody rate="slow">world</prosody> &amp; friends</Say> <Say>Tom &amp; Jerry</Say> </Response>`
+
+	vr, err := ParseVoiceTwiML(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	say := vr.Verbs[0].(*TWiMLSay)
+	if say.Text != "" || !strings.Contains(say.SSML, `<break time="1s"/>`) {
+		t.Errorf("SSML was not preserved: %+v", say)
+	}
+	if plain := vr.Verbs[1].(*TWiMLSay); plain.Text != "Tom & Jerry" || plain.SSML != "" {
+		t.Errorf("unexpected Say: %+v", plain)
+	}
+
+	xml, err := vr.TWiMLVoiceRender()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := space.ReplaceAllString(xml, " "); got != doc {
+		t.Errorf("document did not round-trip:\n%s\n%s", doc, got)
+	}
+}
+
+func TestParseVoiceTwiMLSchemaErrors(t *testing.T) {
+	docs := []string{
+		`<Response><Message>hi</Message></Response>`,
+		`<Response><Gather><Dial>+19135551234</Dial></Gather></Response>`,
+		`<Response><Dial><Say>hi</Say></Dial></Response>`,
+		`<Response>hello</Response>`,
+		`<Response><Play>https://example.com/a.mp3<Say>hi</Say></Play></Response>`,
+		`<Response><Dial><Number><Sip>sip:a@example.com</Sip>+19135551234</Number></Dial></Response>`,
+		`<Response><Record><Foo/></Record></Response>`,
+		`<Response><Hangup>now</Hangup></Response>`,
+		`<Response><Connect><Room>r</Room></Connect></Response>`,
+		`<Reply><Hangup/></Reply>`,
+	}
+	for _, doc := range docs {
+		if _, err := ParseVoiceTwiML(doc); err == nil {
+			t.Errorf("expected an error for %s", doc)
+		}
+	}
+}
+
+func TestParseMessagingResponse(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	doc := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Message to="+19135551234"> <Body>hello world!</Body> <Media>https://demo.twilio.com/owl.png</Media> </Message> <Message>plain</Message> </Response>`
+
+	mr, err := ParseMessagingResponse(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mr.Messages) != 2 || *mr.Messages[0].Body != "hello world!" || mr.Messages[1].Message != "plain" {
+		t.Fatalf("unexpected messages: %+v", mr.Messages)
+	}
+
+	xml, err := mr.TWiMLSmsRender()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := space.ReplaceAllString(xml, " "); got != doc {
+		t.Errorf("document did not round-trip:\n%s\n%s", doc, got)
+	}
+
+	if _, err := ParseMessagingResponse(`<Response><Message><Say>hi</Say></Message></Response>`); err == nil {
+		t.Error("expected an error for a verb nested in Message")
+	}
+}
+
+func TestParseMessagingResponseRedirect(t *testing.T) {
+	mr, err := ParseMessagingResponse(`<Response><Message><Media>a.png</Media><Media>b.png</Media></Message><Redirect method="GET">/next</Redirect></Response>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if urls := mr.Messages[0].mediaUrls(); len(urls) != 2 || urls[1] != "b.png" {
+		t.Errorf("unexpected media: %v", urls)
+	}
+	if mr.RedirectVerb == nil || mr.RedirectVerb.URL != "/next" || mr.RedirectVerb.Method != "GET" {
+		t.Errorf("unexpected redirect: %+v", mr.RedirectVerb)
+	}
+
+	if _, err := ParseMessagingResponse(`<Response><Redirect>/next</Redirect><Message>hi</Message></Response>`); err == nil {
+		t.Error("expected an error for a message after Redirect")
+	}
+	if _, err := ParseMessagingResponse(`<Response><Redirect/></Response>`); err == nil {
+		t.Error("expected an error for a Redirect without URL")
+	}
+}
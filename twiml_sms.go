@@ -3,6 +3,7 @@ package gotwilio
 import (
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -63,9 +64,14 @@ func IsSMSOptInKeyword(s string) bool {
 }
 
 // MessagingResponse Twilio's TWiML sms response
+// See https://www.twilio.com/docs/messaging/twiml
 type MessagingResponse struct {
 	XMLName  xml.Name           `xml:"Response"`
 	Messages []*TWiMLSmsMessage `xml:"Message"`
+
+	// RedirectVerb is rendered after the messages, as Twilio doesn't execute
+	// any verb following a Redirect
+	RedirectVerb *TWiMLSmsRedirect `xml:"Redirect,omitempty"`
 }
 
 // TWiMLSmsMessage response content
@@ -74,18 +80,89 @@ type TWiMLSmsMessage struct {
 
 	// nouns
 	Body     *string `xml:"Body,omitempty"`
-	Media    *string `xml:"Media,omitempty"`
+	Media    *string `xml:"-"`
 	Redirect *string `xml:"Redirect,omitempty"`
 
+	// MediaUrls are rendered as additional Media nouns after Media
+	MediaUrls []string `xml:"-"`
+
 	// verbs - xml attributes
 	Action *string `xml:"Action,attr,omitempty"`
 	Method *string `xml:"Method,attr,omitempty"`
+
+	To             *string `xml:"to,attr,omitempty"`
+	From           *string `xml:"from,attr,omitempty"`
+	StatusCallback *string `xml:"statusCallback,attr,omitempty"`
+
+	// Attrs holds the attributes not modelled above, see ParseMessagingResponse
+	Attrs []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLSmsRedirect transfers control to the TwiML at URL.
+// See https://www.twilio.com/docs/messaging/twiml/redirect
+type TWiMLSmsRedirect struct {
+	URL    string     `xml:",chardata"`
+	Method string     `xml:"method,attr,omitempty"`
+	Attrs  []xml.Attr `xml:",any,attr"`
+}
+
+// mediaUrls returns the URLs of all the Media nouns of the message.
+func (m *TWiMLSmsMessage) mediaUrls() []string {
+	var urls []string
+	if m.Media != nil {
+		urls = append(urls, *m.Media)
+	}
+	return append(urls, m.MediaUrls...)
+}
+
+// MarshalXML implements xml.Marshaler to render Media and MediaUrls as a
+// single list of Media nouns.
+func (m TWiMLSmsMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	shadow := struct {
+		Message        string     `xml:",chardata"`
+		Body           *string    `xml:"Body,omitempty"`
+		Media          []string   `xml:"Media,omitempty"`
+		Redirect       *string    `xml:"Redirect,omitempty"`
+		Action         *string    `xml:"Action,attr,omitempty"`
+		Method         *string    `xml:"Method,attr,omitempty"`
+		To             *string    `xml:"to,attr,omitempty"`
+		From           *string    `xml:"from,attr,omitempty"`
+		StatusCallback *string    `xml:"statusCallback,attr,omitempty"`
+		Attrs          []xml.Attr `xml:",any,attr"`
+	}{
+		Message:        m.Message,
+		Body:           m.Body,
+		Media:          m.mediaUrls(),
+		Redirect:       m.Redirect,
+		Action:         m.Action,
+		Method:         m.Method,
+		To:             m.To,
+		From:           m.From,
+		StatusCallback: m.StatusCallback,
+		Attrs:          m.Attrs,
+	}
+	return e.EncodeElement(shadow, start)
+}
+
+// Validate checks the message against the TwiML schema.
+func (m *TWiMLSmsMessage) Validate() error {
+	media := m.mediaUrls()
+	if (m.Body != nil || len(media) > 0) && (m.Action != nil || m.Method != nil) {
+		return errors.New("can't nest verbs within Message and can't net Message in any other verb")
+	}
+	if len(media) > maxMediaUrls {
+		return fmt.Errorf("Message can have at most %d Media, got %d", maxMediaUrls, len(media))
+	}
+	return nil
 }
 
 // Message add message to TMiML response
 func (r *MessagingResponse) Message(msg *TWiMLSmsMessage) (*MessagingResponse, error) {
-	if (msg.Body != nil || msg.Media != nil) && (msg.Action != nil || msg.Method != nil) {
-		return r, errors.New("can't nest verbs within Message and can't net Message in any other verb")
+	if err := msg.Validate(); err != nil {
+		return r, err
+	}
+	if r.RedirectVerb != nil {
+		return r, errors.New("messages after Redirect are never sent")
 	}
 
 	// twilio doesn't allow message when body is set
@@ -97,11 +174,47 @@ func (r *MessagingResponse) Message(msg *TWiMLSmsMessage) (*MessagingResponse, e
 	return r, nil
 }
 
+// Redirect ends the TwiML response with a Redirect verb.
+func (r *MessagingResponse) Redirect(redirect *TWiMLSmsRedirect) (*MessagingResponse, error) {
+	if strings.TrimSpace(redirect.URL) == "" {
+		return r, errors.New("Redirect requires a URL")
+	}
+	if r.RedirectVerb != nil {
+		return r, errors.New("a response can only contain one Redirect")
+	}
+
+	r.RedirectVerb = redirect
+	return r, nil
+}
+
+// Validate checks every message of the response against the TwiML schema.
+func (r *MessagingResponse) Validate() error {
+	for _, msg := range r.Messages {
+		if err := msg.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.RedirectVerb != nil && strings.TrimSpace(r.RedirectVerb.URL) == "" {
+		return errors.New("Redirect requires a URL")
+	}
+	return nil
+}
+
 // TWiMLSmsRender render XML response to send to Twilio
 func (r *MessagingResponse) TWiMLSmsRender() (string, error) {
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+
 	output, err := xml.MarshalIndent(r, "  ", "   ")
 	if err != nil {
 		return "", err
 	}
 	return xml.Header + string(output), nil
 }
+
+// RenderTwiML implements TwiMLRenderer so a MessagingResponse can be returned
+// from a WebhookHandler callback.
+func (r *MessagingResponse) RenderTwiML() (string, error) {
+	return r.TWiMLSmsRender()
+}
@@ -141,3 +141,47 @@ func TestTWiMLSmsRenderMessageStatus(t *testing.T) {
 		t.Fatalf("TestTWiMLSmsRenderMessageStatus - unexpected xml")
 	}
 }
+
+func TestTWiMLSmsRenderMediaAndRedirect(t *testing.T) {
+	var mr MessagingResponse
+
+	to := "+19135551234"
+	callback := "/status"
+	media := "https://demo.twilio.com/owl.png"
+	if _, err := mr.Message(&TWiMLSmsMessage{
+		To:             &to,
+		StatusCallback: &callback,
+		Media:          &media,
+		MediaUrls:      []string{"https://demo.twilio.com/cat.png"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mr.Redirect(&TWiMLSmsRedirect{URL: "/next", Method: "GET"}); err != nil {
+		t.Fatal(err)
+	}
+
+	xml, err := mr.TWiMLSmsRender()
+	if err != nil {
+		t.Fatalf("failed to render xml: %+v", err)
+	}
+
+	space := regexp.MustCompile(`\s+`)
+	expected := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Message to="+19135551234" statusCallback="/status"> <Media>https://demo.twilio.com/owl.png</Media> <Media>https://demo.twilio.com/cat.png</Media> </Message> <Redirect method="GET">/next</Redirect> </Response>`
+	if got := space.ReplaceAllString(xml, " "); expected != got {
+		t.Fatalf("unexpected xml: %s", got)
+	}
+
+	if _, err := mr.Message(&TWiMLSmsMessage{Message: "unreachable"}); err == nil {
+		t.Error("expected an error when adding a message after Redirect")
+	}
+}
+
+func TestTWiMLSmsMediaLimit(t *testing.T) {
+	var mr MessagingResponse
+	if _, err := mr.Message(&TWiMLSmsMessage{MediaUrls: make([]string, maxMediaUrls+1)}); err == nil {
+		t.Error("expected an error for too many Media")
+	}
+	if len(mr.Messages) != 0 {
+		t.Error("invalid message was added")
+	}
+}
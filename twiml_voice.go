@@ -0,0 +1,648 @@
+package gotwilio
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// VoiceTwiML Twilio's TWiML voice response
+// See https://www.twilio.com/docs/voice/twiml
+//
+// Verbs and nouns keep the attributes their struct doesn't model in Attrs, so
+// a document read by ParseVoiceTwiML renders back unchanged.
+type VoiceTwiML struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []TWiMLVoiceVerb
+}
+
+// TWiMLVoiceVerb is implemented by the verbs that can be nested directly in
+// a VoiceTwiML response.
+type TWiMLVoiceVerb interface {
+	Validate() error
+	voiceVerb()
+}
+
+// TWiMLGatherNested is implemented by the verbs that can be nested in Gather.
+type TWiMLGatherNested interface {
+	Validate() error
+	gatherNested()
+}
+
+// TWiMLDialNoun is implemented by the nouns that can be nested in Dial.
+type TWiMLDialNoun interface {
+	Validate() error
+	dialNoun()
+}
+
+// TWiMLSay reads text to the caller. Set either Text, which is escaped, or
+// SSML, which is written as is and can contain SSML tags such as <break> and
+// <prosody>.
+// See https://www.twilio.com/docs/voice/twiml/say
+type TWiMLSay struct {
+	XMLName  xml.Name   `xml:"Say"`
+	Text     string     `xml:",chardata"`
+	SSML     string     `xml:",innerxml"`
+	Voice    string     `xml:"voice,attr,omitempty"`
+	Language string     `xml:"language,attr,omitempty"`
+	Loop     *int       `xml:"loop,attr,omitempty"`
+	Attrs    []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLPlay plays an audio file or DTMF tones to the caller.
+// See https://www.twilio.com/docs/voice/twiml/play
+type TWiMLPlay struct {
+	XMLName xml.Name   `xml:"Play"`
+	URL     string     `xml:",chardata"`
+	Loop    *int       `xml:"loop,attr,omitempty"`
+	Digits  string     `xml:"digits,attr,omitempty"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLPause waits silently for Length seconds.
+// See https://www.twilio.com/docs/voice/twiml/pause
+type TWiMLPause struct {
+	XMLName xml.Name   `xml:"Pause"`
+	Length  int        `xml:"length,attr,omitempty"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLGather collects digits or speech from the caller while the nested
+// Say, Play and Pause verbs are executed.
+// See https://www.twilio.com/docs/voice/twiml/gather
+type TWiMLGather struct {
+	XMLName                     xml.Name   `xml:"Gather"`
+	Input                       string     `xml:"input,attr,omitempty"`
+	Action                      string     `xml:"action,attr,omitempty"`
+	Method                      string     `xml:"method,attr,omitempty"`
+	Timeout                     int        `xml:"timeout,attr,omitempty"`
+	FinishOnKey                 *string    `xml:"finishOnKey,attr,omitempty"`
+	NumDigits                   int        `xml:"numDigits,attr,omitempty"`
+	SpeechTimeout               string     `xml:"speechTimeout,attr,omitempty"`
+	SpeechModel                 string     `xml:"speechModel,attr,omitempty"`
+	Enhanced                    *bool      `xml:"enhanced,attr,omitempty"`
+	Language                    string     `xml:"language,attr,omitempty"`
+	Hints                       string     `xml:"hints,attr,omitempty"`
+	ProfanityFilter             *bool      `xml:"profanityFilter,attr,omitempty"`
+	PartialResultCallback       string     `xml:"partialResultCallback,attr,omitempty"`
+	PartialResultCallbackMethod string     `xml:"partialResultCallbackMethod,attr,omitempty"`
+	ActionOnEmptyResult         *bool      `xml:"actionOnEmptyResult,attr,omitempty"`
+	Attrs                       []xml.Attr `xml:",any,attr"`
+
+	Nested []TWiMLGatherNested
+}
+
+// TWiMLDial connects the caller to another party. Either set Number to dial
+// a single phone number or add nouns to Nouns.
+// See https://www.twilio.com/docs/voice/twiml/dial
+type TWiMLDial struct {
+	XMLName                       xml.Name   `xml:"Dial"`
+	Number                        string     `xml:",chardata"`
+	Action                        string     `xml:"action,attr,omitempty"`
+	Method                        string     `xml:"method,attr,omitempty"`
+	Timeout                       int        `xml:"timeout,attr,omitempty"`
+	CallerID                      string     `xml:"callerId,attr,omitempty"`
+	CallReason                    string     `xml:"callReason,attr,omitempty"`
+	HangupOnStar                  *bool      `xml:"hangupOnStar,attr,omitempty"`
+	TimeLimit                     int        `xml:"timeLimit,attr,omitempty"`
+	AnswerOnBridge                *bool      `xml:"answerOnBridge,attr,omitempty"`
+	RingTone                      string     `xml:"ringTone,attr,omitempty"`
+	Record                        string     `xml:"record,attr,omitempty"`
+	Trim                          string     `xml:"trim,attr,omitempty"`
+	RecordingStatusCallback       string     `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod string     `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+	RecordingStatusCallbackEvent  string     `xml:"recordingStatusCallbackEvent,attr,omitempty"`
+	Attrs                         []xml.Attr `xml:",any,attr"`
+
+	Nouns []TWiMLDialNoun
+}
+
+// TWiMLNumber dials a phone number from within Dial.
+// See https://www.twilio.com/docs/voice/twiml/number
+type TWiMLNumber struct {
+	XMLName              xml.Name   `xml:"Number"`
+	Number               string     `xml:",chardata"`
+	SendDigits           string     `xml:"sendDigits,attr,omitempty"`
+	URL                  string     `xml:"url,attr,omitempty"`
+	Method               string     `xml:"method,attr,omitempty"`
+	StatusCallback       string     `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod string     `xml:"statusCallbackMethod,attr,omitempty"`
+	StatusCallbackEvent  string     `xml:"statusCallbackEvent,attr,omitempty"`
+	Byoc                 string     `xml:"byoc,attr,omitempty"`
+	Attrs                []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLClient dials a Twilio Client identity from within Dial. Identity is
+// rendered as an Identity noun when Parameters are set.
+// See https://www.twilio.com/docs/voice/twiml/client
+type TWiMLClient struct {
+	XMLName              xml.Name         `xml:"Client"`
+	Identity             string           `xml:",chardata"`
+	URL                  string           `xml:"url,attr,omitempty"`
+	Method               string           `xml:"method,attr,omitempty"`
+	StatusCallback       string           `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod string           `xml:"statusCallbackMethod,attr,omitempty"`
+	StatusCallbackEvent  string           `xml:"statusCallbackEvent,attr,omitempty"`
+	Attrs                []xml.Attr       `xml:",any,attr"`
+	Parameters           []TWiMLParameter `xml:"Parameter,omitempty"`
+}
+
+// TWiMLSip dials a SIP endpoint from within Dial.
+// See https://www.twilio.com/docs/voice/twiml/sip
+type TWiMLSip struct {
+	XMLName              xml.Name   `xml:"Sip"`
+	URI                  string     `xml:",chardata"`
+	Username             string     `xml:"username,attr,omitempty"`
+	Password             string     `xml:"password,attr,omitempty"`
+	URL                  string     `xml:"url,attr,omitempty"`
+	Method               string     `xml:"method,attr,omitempty"`
+	StatusCallback       string     `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod string     `xml:"statusCallbackMethod,attr,omitempty"`
+	StatusCallbackEvent  string     `xml:"statusCallbackEvent,attr,omitempty"`
+	Attrs                []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLConference connects the caller to a conference room from within Dial.
+// See https://www.twilio.com/docs/voice/twiml/conference
+type TWiMLConference struct {
+	XMLName                       xml.Name   `xml:"Conference"`
+	Name                          string     `xml:",chardata"`
+	Muted                         *bool      `xml:"muted,attr,omitempty"`
+	Beep                          string     `xml:"beep,attr,omitempty"`
+	StartConferenceOnEnter        *bool      `xml:"startConferenceOnEnter,attr,omitempty"`
+	EndConferenceOnExit           *bool      `xml:"endConferenceOnExit,attr,omitempty"`
+	WaitURL                       string     `xml:"waitUrl,attr,omitempty"`
+	WaitMethod                    string     `xml:"waitMethod,attr,omitempty"`
+	MaxParticipants               int        `xml:"maxParticipants,attr,omitempty"`
+	Record                        string     `xml:"record,attr,omitempty"`
+	Region                        string     `xml:"region,attr,omitempty"`
+	Coach                         string     `xml:"coach,attr,omitempty"`
+	Trim                          string     `xml:"trim,attr,omitempty"`
+	StatusCallback                string     `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod          string     `xml:"statusCallbackMethod,attr,omitempty"`
+	StatusCallbackEvent           string     `xml:"statusCallbackEvent,attr,omitempty"`
+	RecordingStatusCallback       string     `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod string     `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+	RecordingStatusCallbackEvent  string     `xml:"recordingStatusCallbackEvent,attr,omitempty"`
+	EventCallbackURL              string     `xml:"eventCallbackUrl,attr,omitempty"`
+	Attrs                         []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLQueue connects the caller to the call at the front of a queue from
+// within Dial.
+// See https://www.twilio.com/docs/voice/twiml/queue
+type TWiMLQueue struct {
+	XMLName             xml.Name   `xml:"Queue"`
+	Name                string     `xml:",chardata"`
+	URL                 string     `xml:"url,attr,omitempty"`
+	Method              string     `xml:"method,attr,omitempty"`
+	ReservationSid      string     `xml:"reservationSid,attr,omitempty"`
+	PostWorkActivitySid string     `xml:"postWorkActivitySid,attr,omitempty"`
+	Attrs               []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLRecord records the caller's voice.
+// See https://www.twilio.com/docs/voice/twiml/record
+type TWiMLRecord struct {
+	XMLName                       xml.Name   `xml:"Record"`
+	Action                        string     `xml:"action,attr,omitempty"`
+	Method                        string     `xml:"method,attr,omitempty"`
+	Timeout                       int        `xml:"timeout,attr,omitempty"`
+	FinishOnKey                   *string    `xml:"finishOnKey,attr,omitempty"`
+	MaxLength                     int        `xml:"maxLength,attr,omitempty"`
+	PlayBeep                      *bool      `xml:"playBeep,attr,omitempty"`
+	Trim                          string     `xml:"trim,attr,omitempty"`
+	RecordingStatusCallback       string     `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod string     `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+	RecordingStatusCallbackEvent  string     `xml:"recordingStatusCallbackEvent,attr,omitempty"`
+	Transcribe                    *bool      `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback            string     `xml:"transcribeCallback,attr,omitempty"`
+	Attrs                         []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLEnqueue places the caller in a queue.
+// See https://www.twilio.com/docs/voice/twiml/enqueue
+type TWiMLEnqueue struct {
+	XMLName       xml.Name   `xml:"Enqueue"`
+	Name          string     `xml:",chardata"`
+	Action        string     `xml:"action,attr,omitempty"`
+	Method        string     `xml:"method,attr,omitempty"`
+	WaitURL       string     `xml:"waitUrl,attr,omitempty"`
+	WaitURLMethod string     `xml:"waitUrlMethod,attr,omitempty"`
+	WorkflowSid   string     `xml:"workflowSid,attr,omitempty"`
+	Attrs         []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLHangup ends the call.
+// See https://www.twilio.com/docs/voice/twiml/hangup
+type TWiMLHangup struct {
+	XMLName xml.Name   `xml:"Hangup"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLRedirect transfers control of the call to the TwiML at URL.
+// See https://www.twilio.com/docs/voice/twiml/redirect
+type TWiMLRedirect struct {
+	XMLName xml.Name   `xml:"Redirect"`
+	URL     string     `xml:",chardata"`
+	Method  string     `xml:"method,attr,omitempty"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLReject rejects an incoming call without answering it.
+// See https://www.twilio.com/docs/voice/twiml/reject
+type TWiMLReject struct {
+	XMLName xml.Name   `xml:"Reject"`
+	Reason  string     `xml:"reason,attr,omitempty"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLLeave moves the caller out of the queue it is waiting in.
+// See https://www.twilio.com/docs/voice/twiml/leave
+type TWiMLLeave struct {
+	XMLName xml.Name   `xml:"Leave"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+}
+
+// TWiMLConnect connects the call to a media stream.
+// See https://www.twilio.com/docs/voice/twiml/connect
+type TWiMLConnect struct {
+	XMLName xml.Name     `xml:"Connect"`
+	Action  string       `xml:"action,attr,omitempty"`
+	Method  string       `xml:"method,attr,omitempty"`
+	Attrs   []xml.Attr   `xml:",any,attr"`
+	Stream  *TWiMLStream `xml:"Stream,omitempty"`
+}
+
+// TWiMLStream streams the call audio to a WebSocket from within Connect.
+// See https://www.twilio.com/docs/voice/twiml/stream
+type TWiMLStream struct {
+	XMLName              xml.Name         `xml:"Stream"`
+	URL                  string           `xml:"url,attr"`
+	Name                 string           `xml:"name,attr,omitempty"`
+	Track                string           `xml:"track,attr,omitempty"`
+	StatusCallback       string           `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod string           `xml:"statusCallbackMethod,attr,omitempty"`
+	Attrs                []xml.Attr       `xml:",any,attr"`
+	Parameters           []TWiMLParameter `xml:"Parameter,omitempty"`
+}
+
+// TWiMLParameter passes a custom key/value pair to a Client or Stream.
+type TWiMLParameter struct {
+	Name  string     `xml:"name,attr"`
+	Value string     `xml:"value,attr"`
+	Attrs []xml.Attr `xml:",any,attr"`
+}
+
+func (*TWiMLSay) voiceVerb()      {}
+func (*TWiMLPlay) voiceVerb()     {}
+func (*TWiMLPause) voiceVerb()    {}
+func (*TWiMLGather) voiceVerb()   {}
+func (*TWiMLDial) voiceVerb()     {}
+func (*TWiMLRecord) voiceVerb()   {}
+func (*TWiMLEnqueue) voiceVerb()  {}
+func (*TWiMLHangup) voiceVerb()   {}
+func (*TWiMLRedirect) voiceVerb() {}
+func (*TWiMLReject) voiceVerb()   {}
+func (*TWiMLLeave) voiceVerb()    {}
+func (*TWiMLConnect) voiceVerb()  {}
+
+func (*TWiMLSay) gatherNested()   {}
+func (*TWiMLPlay) gatherNested()  {}
+func (*TWiMLPause) gatherNested() {}
+
+func (*TWiMLNumber) dialNoun()     {}
+func (*TWiMLClient) dialNoun()     {}
+func (*TWiMLSip) dialNoun()        {}
+func (*TWiMLConference) dialNoun() {}
+func (*TWiMLQueue) dialNoun()      {}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLSay) Validate() error {
+	hasText, hasSSML := strings.TrimSpace(v.Text) != "", strings.TrimSpace(v.SSML) != ""
+	if !hasText && !hasSSML {
+		return errors.New("Say requires text")
+	}
+	if hasText && hasSSML {
+		return errors.New("Say can't have both Text and SSML")
+	}
+	if v.Loop != nil && *v.Loop < 0 {
+		return errors.New("Say loop can't be negative")
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLPlay) Validate() error {
+	if v.URL == "" && v.Digits == "" {
+		return errors.New("Play requires a URL or digits")
+	}
+	if v.Loop != nil && *v.Loop < 0 {
+		return errors.New("Play loop can't be negative")
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLPause) Validate() error {
+	if v.Length < 0 {
+		return errors.New("Pause length can't be negative")
+	}
+	return nil
+}
+
+// Validate checks the verb and its nested verbs against the TwiML schema.
+func (v *TWiMLGather) Validate() error {
+	for _, input := range strings.Fields(v.Input) {
+		if input != "dtmf" && input != "speech" {
+			return fmt.Errorf("Gather input %q must be dtmf, speech or both", v.Input)
+		}
+	}
+	if v.Timeout < 0 || v.NumDigits < 0 {
+		return errors.New("Gather timeout and numDigits can't be negative")
+	}
+	for _, nested := range v.Nested {
+		if nested == nil {
+			return errors.New("Gather can't contain a nil verb")
+		}
+		if err := nested.Validate(); err != nil {
+			return fmt.Errorf("Gather: %v", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the verb and its nouns against the TwiML schema.
+func (v *TWiMLDial) Validate() error {
+	number := strings.TrimSpace(v.Number) != ""
+	if number == (len(v.Nouns) > 0) {
+		return errors.New("Dial requires either a number or nouns, but not both")
+	}
+
+	numbers, exclusive := 0, 0
+	for _, noun := range v.Nouns {
+		switch noun.(type) {
+		case nil:
+			return errors.New("Dial can't contain a nil noun")
+		case *TWiMLNumber:
+			numbers++
+		case *TWiMLConference, *TWiMLQueue:
+			exclusive++
+		}
+		if err := noun.Validate(); err != nil {
+			return fmt.Errorf("Dial: %v", err)
+		}
+	}
+	if exclusive > 0 && len(v.Nouns) > 1 {
+		return errors.New("Dial can't combine Conference or Queue with other nouns")
+	}
+	if numbers > 10 {
+		return errors.New("Dial can contain at most 10 Number nouns")
+	}
+	if v.Timeout < 0 || v.TimeLimit < 0 {
+		return errors.New("Dial timeout and timeLimit can't be negative")
+	}
+	return nil
+}
+
+// Validate checks the noun against the TwiML schema.
+func (v *TWiMLNumber) Validate() error {
+	if strings.TrimSpace(v.Number) == "" {
+		return errors.New("Number requires a phone number")
+	}
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler to render Identity as an Identity
+// noun when Parameters are set, as Twilio requires.
+func (v TWiMLClient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type client TWiMLClient
+	start.Name = xml.Name{Local: "Client"}
+	if len(v.Parameters) == 0 {
+		return e.EncodeElement(client(v), start)
+	}
+
+	shadow := struct {
+		URL                  string           `xml:"url,attr,omitempty"`
+		Method               string           `xml:"method,attr,omitempty"`
+		StatusCallback       string           `xml:"statusCallback,attr,omitempty"`
+		StatusCallbackMethod string           `xml:"statusCallbackMethod,attr,omitempty"`
+		StatusCallbackEvent  string           `xml:"statusCallbackEvent,attr,omitempty"`
+		Attrs                []xml.Attr       `xml:",any,attr"`
+		Identity             string           `xml:"Identity"`
+		Parameters           []TWiMLParameter `xml:"Parameter"`
+	}{
+		URL:                  v.URL,
+		Method:               v.Method,
+		StatusCallback:       v.StatusCallback,
+		StatusCallbackMethod: v.StatusCallbackMethod,
+		StatusCallbackEvent:  v.StatusCallbackEvent,
+		Attrs:                v.Attrs,
+		Identity:             v.Identity,
+		Parameters:           v.Parameters,
+	}
+	return e.EncodeElement(shadow, start)
+}
+
+// Validate checks the noun against the TwiML schema.
+func (v *TWiMLClient) Validate() error {
+	if strings.TrimSpace(v.Identity) == "" {
+		return errors.New("Client requires an identity")
+	}
+	return nil
+}
+
+// Validate checks the noun against the TwiML schema.
+func (v *TWiMLSip) Validate() error {
+	if !strings.HasPrefix(strings.TrimSpace(strings.ToLower(v.URI)), "sip:") {
+		return errors.New("Sip requires a sip: URI")
+	}
+	return nil
+}
+
+// Validate checks the noun against the TwiML schema.
+func (v *TWiMLConference) Validate() error {
+	if strings.TrimSpace(v.Name) == "" {
+		return errors.New("Conference requires a name")
+	}
+	if v.MaxParticipants != 0 && (v.MaxParticipants < 2 || v.MaxParticipants > 250) {
+		return errors.New("Conference maxParticipants must be between 2 and 250")
+	}
+	return nil
+}
+
+// Validate checks the noun against the TwiML schema.
+func (v *TWiMLQueue) Validate() error {
+	if strings.TrimSpace(v.Name) == "" {
+		return errors.New("Queue requires a name")
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLRecord) Validate() error {
+	if v.Timeout < 0 || v.MaxLength < 0 {
+		return errors.New("Record timeout and maxLength can't be negative")
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLEnqueue) Validate() error {
+	if strings.TrimSpace(v.Name) == "" && v.WorkflowSid == "" {
+		return errors.New("Enqueue requires a queue name or a workflowSid")
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLHangup) Validate() error {
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLRedirect) Validate() error {
+	if strings.TrimSpace(v.URL) == "" {
+		return errors.New("Redirect requires a URL")
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLReject) Validate() error {
+	if v.Reason != "" && v.Reason != "rejected" && v.Reason != "busy" {
+		return fmt.Errorf("Reject reason %q must be rejected or busy", v.Reason)
+	}
+	return nil
+}
+
+// Validate checks the verb against the TwiML schema.
+func (v *TWiMLLeave) Validate() error {
+	return nil
+}
+
+// Validate checks the verb and its stream against the TwiML schema.
+func (v *TWiMLConnect) Validate() error {
+	if v.Stream == nil {
+		return errors.New("Connect requires a Stream")
+	}
+	return v.Stream.Validate()
+}
+
+// Validate checks the noun against the TwiML schema.
+func (v *TWiMLStream) Validate() error {
+	if !strings.HasPrefix(v.URL, "wss://") {
+		return errors.New("Stream requires a wss:// URL")
+	}
+	switch v.Track {
+	case "", "inbound_track", "outbound_track", "both_tracks":
+	default:
+		return fmt.Errorf("Stream track %q is not supported", v.Track)
+	}
+	return nil
+}
+
+// endsCall reports whether no verb can be executed after verb.
+func endsCall(verb TWiMLVoiceVerb) bool {
+	switch verb.(type) {
+	case *TWiMLHangup, *TWiMLRedirect, *TWiMLReject:
+		return true
+	}
+	return false
+}
+
+// Append validates verb and adds it to the TwiML response.
+func (r *VoiceTwiML) Append(verb TWiMLVoiceVerb) (*VoiceTwiML, error) {
+	if verb == nil {
+		return r, errors.New("can't append a nil verb")
+	}
+	if err := verb.Validate(); err != nil {
+		return r, err
+	}
+	if n := len(r.Verbs); n > 0 && endsCall(r.Verbs[n-1]) {
+		return r, fmt.Errorf("verbs after %s are never executed", xmlName(r.Verbs[n-1]))
+	}
+
+	r.Verbs = append(r.Verbs, verb)
+	return r, nil
+}
+
+// Say adds a Say verb to the TwiML response.
+func (r *VoiceTwiML) Say(say *TWiMLSay) (*VoiceTwiML, error) { return r.Append(say) }
+
+// Play adds a Play verb to the TwiML response.
+func (r *VoiceTwiML) Play(play *TWiMLPlay) (*VoiceTwiML, error) { return r.Append(play) }
+
+// Pause adds a Pause verb to the TwiML response.
+func (r *VoiceTwiML) Pause(pause *TWiMLPause) (*VoiceTwiML, error) { return r.Append(pause) }
+
+// Gather adds a Gather verb to the TwiML response.
+func (r *VoiceTwiML) Gather(gather *TWiMLGather) (*VoiceTwiML, error) { return r.Append(gather) }
+
+// Dial adds a Dial verb to the TwiML response.
+func (r *VoiceTwiML) Dial(dial *TWiMLDial) (*VoiceTwiML, error) { return r.Append(dial) }
+
+// Record adds a Record verb to the TwiML response.
+func (r *VoiceTwiML) Record(record *TWiMLRecord) (*VoiceTwiML, error) { return r.Append(record) }
+
+// Enqueue adds an Enqueue verb to the TwiML response.
+func (r *VoiceTwiML) Enqueue(enqueue *TWiMLEnqueue) (*VoiceTwiML, error) { return r.Append(enqueue) }
+
+// Hangup adds a Hangup verb to the TwiML response.
+func (r *VoiceTwiML) Hangup() (*VoiceTwiML, error) { return r.Append(&TWiMLHangup{}) }
+
+// Redirect adds a Redirect verb to the TwiML response.
+func (r *VoiceTwiML) Redirect(redirect *TWiMLRedirect) (*VoiceTwiML, error) {
+	return r.Append(redirect)
+}
+
+// Reject adds a Reject verb to the TwiML response.
+func (r *VoiceTwiML) Reject(reject *TWiMLReject) (*VoiceTwiML, error) { return r.Append(reject) }
+
+// Leave adds a Leave verb to the TwiML response.
+func (r *VoiceTwiML) Leave() (*VoiceTwiML, error) { return r.Append(&TWiMLLeave{}) }
+
+// Connect adds a Connect verb to the TwiML response.
+func (r *VoiceTwiML) Connect(connect *TWiMLConnect) (*VoiceTwiML, error) { return r.Append(connect) }
+
+// Validate checks every verb of the response against the TwiML schema.
+func (r *VoiceTwiML) Validate() error {
+	for i, verb := range r.Verbs {
+		if verb == nil {
+			return errors.New("Response can't contain a nil verb")
+		}
+		if err := verb.Validate(); err != nil {
+			return err
+		}
+		if endsCall(verb) && i < len(r.Verbs)-1 {
+			return fmt.Errorf("verbs after %s are never executed", xmlName(verb))
+		}
+	}
+	return nil
+}
+
+// TWiMLVoiceRender validates and renders the XML response to send to Twilio
+func (r *VoiceTwiML) TWiMLVoiceRender() (string, error) {
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+
+	output, err := xml.MarshalIndent(r, "  ", "   ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(output), nil
+}
+
+// RenderTwiML implements TwiMLRenderer so a VoiceTwiML can be returned from a
+// WebhookHandler callback.
+func (r *VoiceTwiML) RenderTwiML() (string, error) {
+	return r.TWiMLVoiceRender()
+}
+
+// xmlName returns the element name of a TwiML verb or noun.
+func xmlName(v interface{}) string {
+	name := fmt.Sprintf("%T", v)
+	return strings.TrimPrefix(name, "*gotwilio.TWiML")
+}
@@ -0,0 +1,130 @@
+package gotwilio
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestTWiMLVoiceRenderIVR(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	var vr VoiceTwiML
+
+	loop := 2
+	if _, err := vr.Gather(&TWiMLGather{
+		Input:     "dtmf speech",
+		Action:    "/menu",
+		NumDigits: 1,
+		Nested: []TWiMLGatherNested{
+			&TWiMLSay{Text: "Press 1 for sales.", Voice: "alice", Loop: &loop},
+			&TWiMLPause{Length: 1},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vr.Dial(&TWiMLDial{
+		CallerID: "+15005550006",
+		Nouns: []TWiMLDialNoun{
+			&TWiMLNumber{Number: "+19135551234", SendDigits: "1"},
+			&TWiMLClient{Identity: "agent"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vr.Hangup(); err != nil {
+		t.Fatal(err)
+	}
+
+	xml, err := vr.TWiMLVoiceRender()
+	if err != nil {
+		t.Fatalf("failed to render xml: %+v", err)
+	}
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Gather input="dtmf speech" action="/menu" numDigits="1"> <Say voice="alice" loop="2">Press 1 for sales.</Say> <Pause length="1"></Pause> </Gather> <Dial callerId="+15005550006"> <Number sendDigits="1">+19135551234</Number> <Client>agent</Client> </Dial> <Hangup></Hangup> </Response>`
+	if got := space.ReplaceAllString(xml, " "); expected != got {
+		t.Fatalf("unexpected xml: %s", got)
+	}
+}
+
+func TestTWiMLVoiceRenderClientParameters(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	var vr VoiceTwiML
+
+	if _, err := vr.Dial(&TWiMLDial{Nouns: []TWiMLDialNoun{&TWiMLClient{
+		Identity:   "bob",
+		URL:        "/screen",
+		Parameters: []TWiMLParameter{{Name: "ticket", Value: "42"}},
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	xml, err := vr.RenderTwiML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Dial> <Client url="/screen"> <Identity>bob</Identity> <Parameter name="ticket" value="42"></Parameter> </Client> </Dial> </Response>`
+	if got := space.ReplaceAllString(xml, " "); expected != got {
+		t.Fatalf("unexpected xml: %s", got)
+	}
+}
+
+func TestTWiMLVoiceRenderConnectStream(t *testing.T) {
+	space := regexp.MustCompile(`\s+`)
+	var vr VoiceTwiML
+
+	_, err := vr.Connect(&TWiMLConnect{Stream: &TWiMLStream{
+		URL:        "wss://example.com/audio",
+		Parameters: []TWiMLParameter{{Name: "caller", Value: "alice"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xml, err := vr.RenderTwiML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?> <Response> <Connect> <Stream url="wss://example.com/audio"> <Parameter name="caller" value="alice"></Parameter> </Stream> </Connect> </Response>`
+	if got := space.ReplaceAllString(xml, " "); expected != got {
+		t.Fatalf("unexpected xml: %s", got)
+	}
+}
+
+func TestTWiMLVoiceNestingRules(t *testing.T) {
+	tests := []struct {
+		name string
+		verb TWiMLVoiceVerb
+	}{
+		{"empty say", &TWiMLSay{}},
+		{"say text and ssml", &TWiMLSay{Text: "a", SSML: `<break time="1s"/>`}},
+		{"empty dial", &TWiMLDial{}},
+		{"dial number and nouns", &TWiMLDial{Number: "+19135551234", Nouns: []TWiMLDialNoun{&TWiMLClient{Identity: "agent"}}}},
+		{"conference with number", &TWiMLDial{Nouns: []TWiMLDialNoun{&TWiMLConference{Name: "room"}, &TWiMLNumber{Number: "+19135551234"}}}},
+		{"two queues", &TWiMLDial{Nouns: []TWiMLDialNoun{&TWiMLQueue{Name: "a"}, &TWiMLQueue{Name: "b"}}}},
+		{"invalid sip", &TWiMLDial{Nouns: []TWiMLDialNoun{&TWiMLSip{URI: "alice@example.com"}}}},
+		{"invalid gather input", &TWiMLGather{Input: "voice"}},
+		{"invalid gather nested", &TWiMLGather{Nested: []TWiMLGatherNested{&TWiMLPlay{}}}},
+		{"connect without stream", &TWiMLConnect{}},
+		{"insecure stream", &TWiMLConnect{Stream: &TWiMLStream{URL: "http://example.com"}}},
+		{"invalid reject reason", &TWiMLReject{Reason: "nope"}},
+		{"redirect without url", &TWiMLRedirect{}},
+		{"enqueue without name", &TWiMLEnqueue{}},
+	}
+	for _, test := range tests {
+		var vr VoiceTwiML
+		if _, err := vr.Append(test.verb); err == nil {
+			t.Errorf("%s: expected a validation error", test.name)
+		}
+		if len(vr.Verbs) != 0 {
+			t.Errorf("%s: invalid verb was appended", test.name)
+		}
+	}
+
+	var vr VoiceTwiML
+	if _, err := vr.Redirect(&TWiMLRedirect{URL: "/next"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vr.Say(&TWiMLSay{Text: "unreachable"}); err == nil {
+		t.Error("expected an error when appending after Redirect")
+	}
+}
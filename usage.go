@@ -50,11 +50,7 @@ func (twilio *Twilio) GetUsage(category, startDate, endDate string, includeSubac
 }
 
 func (twilio *Twilio) GetUsageWithContext(ctx context.Context, category, startDate, endDate string, includeSubaccounts bool) (*UsageResponse, *Exception, error) {
-	formValues := url.Values{}
-	formValues.Set("category", category)
-	formValues.Set("start_date", startDate)
-	formValues.Set("end_date", endDate)
-	formValues.Set("include_subaccounts", strconv.FormatBool(includeSubaccounts))
+	formValues := usageQuery(category, startDate, endDate, includeSubaccounts)
 
 	var usageResponse *UsageResponse
 	var exception *Exception
@@ -80,3 +76,23 @@ func (twilio *Twilio) GetUsageWithContext(ctx context.Context, category, startDa
 	err = json.Unmarshal(responseBody, usageResponse)
 	return usageResponse, nil, err
 }
+
+// GetUsageIterator returns an iterator over all usage records matching the
+// given parameters, fetching pages as they are needed.
+func (twilio *Twilio) GetUsageIterator(category, startDate, endDate string, includeSubaccounts bool, opts *PageOptions) UsageRecordIterator {
+	return twilio.GetUsageIteratorWithContext(context.Background(), category, startDate, endDate, includeSubaccounts, opts)
+}
+
+func (twilio *Twilio) GetUsageIteratorWithContext(ctx context.Context, category, startDate, endDate string, includeSubaccounts bool, opts *PageOptions) UsageRecordIterator {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Usage/Records.json?" + usageQuery(category, startDate, endDate, includeSubaccounts).Encode()
+	return UsageRecordIterator{twilio.newIterator(ctx, twilioUrl, "usage_records", opts, func() interface{} { return new(UsageRecord) })}
+}
+
+func usageQuery(category, startDate, endDate string, includeSubaccounts bool) url.Values {
+	formValues := url.Values{}
+	formValues.Set("category", category)
+	formValues.Set("start_date", startDate)
+	formValues.Set("end_date", endDate)
+	formValues.Set("include_subaccounts", strconv.FormatBool(includeSubaccounts))
+	return formValues
+}
@@ -4,17 +4,25 @@ import (
 	"bytes"
 	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"sort"
+	"strings"
 )
 
 // GenerateSignature computes the Twilio signature for verifying the
 // authenticity of a request.  It is based on the specification at:
 // https://www.twilio.com/docs/security#validating-requests
 func (twilio *Twilio) GenerateSignature(url string, form url.Values) ([]byte, error) {
+	return generateSignature(twilio.AuthToken, url, form)
+}
+
+func generateSignature(authToken string, url string, form url.Values) ([]byte, error) {
 	var buf bytes.Buffer
 
 	buf.WriteString(url)
@@ -33,7 +41,7 @@ func (twilio *Twilio) GenerateSignature(url string, form url.Values) ([]byte, er
 		}
 	}
 
-	mac := hmac.New(sha1.New, []byte(twilio.AuthToken))
+	mac := hmac.New(sha1.New, []byte(authToken))
 	mac.Write(buf.Bytes())
 
 	var expected bytes.Buffer
@@ -57,28 +65,99 @@ func (twilio *Twilio) GenerateSignature(url string, form url.Values) ([]byte, er
 // The baseUrl parameter will be prepended to the request URL. It is useful for
 // specifying the protocol and host parts of the server URL hosting your endpoint.
 //
-// Passing a non-POST request or a request without the X-Twilio-Signature
-// header is an error.
+// POST requests are signed over the URL and the form parameters, any other
+// method (e.g. a StatusCallbackMethod=GET callback) over the full URL
+// including its query string. Requests with a JSON body carry a bodySHA256
+// query parameter, which must match the SHA-256 hash of the body. Since
+// Twilio may sign the URL with or without the default port, both variants
+// are accepted.
+//
+// Passing a request without the X-Twilio-Signature header is an error.
 func (twilio *Twilio) CheckRequestSignature(r *http.Request, baseURL string) (bool, error) {
-	if r.Method != "POST" {
-		return false, errors.New("Checking signatures on non-POST requests is not implemented")
-	}
+	match, err := checkSignature(r, baseURL+r.URL.String(), []string{twilio.AuthToken})
+	return match == 0, err
+}
 
-	if err := r.ParseForm(); err != nil {
-		return false, err
+// CheckRequestSignatureWithTokens works like CheckRequestSignature but
+// accepts a signature made with any of authTokens, such as the primary and
+// secondary auth token while rotating credentials. It returns the index of
+// the token that matched, or -1 if none did. Without authTokens only
+// Twilio.AuthToken is accepted.
+func (twilio *Twilio) CheckRequestSignatureWithTokens(r *http.Request, baseURL string, authTokens ...string) (int, error) {
+	if len(authTokens) == 0 {
+		authTokens = []string{twilio.AuthToken}
 	}
+	return checkSignature(r, baseURL+r.URL.String(), authTokens)
+}
 
-	url := baseURL + r.URL.String()
+// checkSignature checks the X-Twilio-Signature header of r against the full
+// URL Twilio requested and returns the index of the matching auth token.
+func checkSignature(r *http.Request, rawURL string, authTokens []string) (int, error) {
+	actual := r.Header.Get("X-Twilio-Signature")
+	if actual == "" {
+		return -1, errors.New("Request does not have a twilio signature header")
+	}
 
-	expected, err := twilio.GenerateSignature(url, r.PostForm)
+	u, err := url.Parse(rawURL)
 	if err != nil {
-		return false, err
+		return -1, err
 	}
 
-	actual := r.Header.Get("X-Twilio-Signature")
-	if actual == "" {
-		return false, errors.New("Request does not have a twilio signature header")
+	var params url.Values
+	if bodyHash := u.Query().Get("bodySHA256"); bodyHash != "" {
+		// JSON bodies are not part of the signature, their hash is.
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return -1, err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		sum := sha256.Sum256(body)
+		if !hmac.Equal([]byte(hex.EncodeToString(sum[:])), []byte(strings.ToLower(bodyHash))) {
+			return -1, nil
+		}
+	} else if r.Method == "POST" {
+		if err := r.ParseForm(); err != nil {
+			return -1, err
+		}
+		params = r.PostForm
 	}
 
-	return hmac.Equal(expected, []byte(actual)), nil
+	// Make the query parameters available to callers of non-POST requests.
+	if err := r.ParseForm(); err != nil {
+		return -1, err
+	}
+
+	candidates := signatureURLs(rawURL, u)
+	for i, authToken := range authTokens {
+		for _, candidate := range candidates {
+			expected, err := generateSignature(authToken, candidate, params)
+			if err != nil {
+				return -1, err
+			}
+			if hmac.Equal(expected, []byte(actual)) {
+				return i, nil
+			}
+		}
+	}
+	return -1, nil
+}
+
+// signatureURLs returns the URL as requested along with the variant with the
+// default port added or removed, as Twilio may have signed either.
+func signatureURLs(rawURL string, u *url.URL) []string {
+	defaultPort := map[string]string{"http": "80", "https": "443"}[u.Scheme]
+	if defaultPort == "" || u.Host == "" {
+		return []string{rawURL}
+	}
+
+	variant := *u
+	if port := u.Port(); port == "" {
+		variant.Host = u.Host + ":" + defaultPort
+	} else if port == defaultPort {
+		variant.Host = strings.TrimSuffix(u.Host, ":"+port)
+	} else {
+		return []string{rawURL}
+	}
+	return []string{rawURL, variant.String()}
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -68,3 +69,126 @@ func TestCheckSignature(t *testing.T) {
 		t.Fatal("Expected an error verifying a request without a signature header")
 	}
 }
+
+func TestCheckSignatureGET(t *testing.T) {
+	twilio := Twilio{
+		AuthToken: testAuthToken,
+	}
+
+	target := "https://example.com/status?CallSid=CA1&CallStatus=completed"
+	sig, err := twilio.GenerateSignature(target, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest("GET", "/status?CallSid=CA1&CallStatus=completed", nil)
+	r.Header.Set("X-Twilio-Signature", string(sig))
+
+	valid, err := twilio.CheckRequestSignature(r, "https://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("Expected GET signature to be valid")
+	}
+	if r.Form.Get("CallSid") != "CA1" {
+		t.Error("Expected query parameters to be parsed")
+	}
+
+	// Twilio may sign with the default port added.
+	valid, err = twilio.CheckRequestSignature(r, "https://example.com:443")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("Expected signature to be valid with the default port")
+	}
+
+	r = httptest.NewRequest("GET", "/status?CallSid=CA2&CallStatus=completed", nil)
+	r.Header.Set("X-Twilio-Signature", string(sig))
+	if valid, _ = twilio.CheckRequestSignature(r, "https://example.com"); valid {
+		t.Fatal("Expected tampered query to be invalid")
+	}
+}
+
+func TestCheckSignatureBodySHA256(t *testing.T) {
+	twilio := Twilio{
+		AuthToken: testAuthToken,
+	}
+
+	body := `{"property": "value", "boolean": true}`
+	hash := "0a1ff7634d9ab3b95db5c9a2dfe9416e41502b283a80c7cf19632632f96e6620"
+	target := "https://example.com/myapp?bodySHA256=" + hash
+	sig, err := twilio.GenerateSignature(target, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest("POST", "/myapp?bodySHA256="+hash, bytes.NewBufferString(body))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Twilio-Signature", string(sig))
+
+	valid, err := twilio.CheckRequestSignature(r, "https://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("Expected JSON body signature to be valid")
+	}
+	if b, _ := ioutil.ReadAll(r.Body); string(b) != body {
+		t.Error("Expected body to still be readable")
+	}
+
+	r = httptest.NewRequest("POST", "/myapp?bodySHA256="+hash, bytes.NewBufferString(`{"property": "tampered"}`))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Twilio-Signature", string(sig))
+	if valid, _ = twilio.CheckRequestSignature(r, "https://example.com"); valid {
+		t.Fatal("Expected tampered body to be invalid")
+	}
+}
+
+func TestCheckSignatureWithTokens(t *testing.T) {
+	secondary := Twilio{
+		AuthToken: "secondary",
+	}
+
+	form := url.Values{"CallSid": {"CA1"}}
+	sig, err := secondary.GenerateSignature(testServerURL+"/voice", form)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest("POST", "/voice", bytes.NewBufferString(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set("X-Twilio-Signature", string(sig))
+		return r
+	}
+
+	twilio := Twilio{
+		AuthToken: testAuthToken,
+	}
+	valid, err := twilio.CheckRequestSignature(newRequest(), testServerURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Fatal("Expected signature made with another token to be invalid")
+	}
+
+	match, err := twilio.CheckRequestSignatureWithTokens(newRequest(), testServerURL, testAuthToken, "secondary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match != 1 {
+		t.Fatalf("Expected the secondary token to match, got %d", match)
+	}
+
+	match, err = twilio.CheckRequestSignatureWithTokens(newRequest(), testServerURL, testAuthToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match != -1 {
+		t.Fatalf("Expected no token to match, got %d", match)
+	}
+}
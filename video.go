@@ -174,21 +174,7 @@ func (twilio *Twilio) ListVideoRooms(options *ListVideoRoomOptions) (videoRespon
 }
 
 func (twilio *Twilio) ListVideoRoomsWithContext(ctx context.Context, options *ListVideoRoomOptions) (videoResponse *ListVideoReponse, exception *Exception, err error) {
-	q := &url.Values{}
-	if !options.DateCreatedAfter.Equal(time.Time{}) {
-		q.Set("DateCreatedAfter", options.DateCreatedAfter.Format(time.RFC3339))
-	}
-	if !options.DateCreatedBefore.Equal(time.Time{}) {
-		q.Set("DateCreatedBefore", options.DateCreatedBefore.Format(time.RFC3339))
-	}
-	if options.Status != "" {
-		q.Set("Status", fmt.Sprintf("%s", options.Status))
-	}
-	if options.UniqueName != "" {
-		q.Set("UniqueName", options.UniqueName)
-	}
-
-	twilioUrl := twilio.VideoUrl + "/v1/Rooms?" + q.Encode()
+	twilioUrl := twilio.VideoUrl + "/v1/Rooms?" + listVideoRoomsQuery(options).Encode()
 
 	res, err := twilio.get(ctx, twilioUrl)
 	if err != nil {
@@ -215,6 +201,39 @@ func (twilio *Twilio) ListVideoRoomsWithContext(ctx context.Context, options *Li
 	return videoResponse, exception, err
 }
 
+// ListVideoRoomsIterator returns an iterator over all video rooms matching
+// options, fetching pages as they are needed.
+// See https://www.twilio.com/docs/video/api/rooms-resource
+// for more information.
+func (twilio *Twilio) ListVideoRoomsIterator(options *ListVideoRoomOptions, opts *PageOptions) VideoRoomIterator {
+	return twilio.ListVideoRoomsIteratorWithContext(context.Background(), options, opts)
+}
+
+func (twilio *Twilio) ListVideoRoomsIteratorWithContext(ctx context.Context, options *ListVideoRoomOptions, opts *PageOptions) VideoRoomIterator {
+	twilioUrl := twilio.VideoUrl + "/v1/Rooms?" + listVideoRoomsQuery(options).Encode()
+	return VideoRoomIterator{twilio.newIterator(ctx, twilioUrl, "rooms", opts, func() interface{} { return new(VideoResponse) })}
+}
+
+func listVideoRoomsQuery(options *ListVideoRoomOptions) url.Values {
+	q := url.Values{}
+	if options == nil {
+		return q
+	}
+	if !options.DateCreatedAfter.Equal(time.Time{}) {
+		q.Set("DateCreatedAfter", options.DateCreatedAfter.Format(time.RFC3339))
+	}
+	if !options.DateCreatedBefore.Equal(time.Time{}) {
+		q.Set("DateCreatedBefore", options.DateCreatedBefore.Format(time.RFC3339))
+	}
+	if options.Status != "" {
+		q.Set("Status", fmt.Sprintf("%s", options.Status))
+	}
+	if options.UniqueName != "" {
+		q.Set("UniqueName", options.UniqueName)
+	}
+	return q
+}
+
 // GetVideoRoom retrievs a single video session
 // by name or by Sid.
 // See https://www.twilio.com/docs/video/api/rooms-resource
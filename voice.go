@@ -3,10 +3,13 @@ package gotwilio
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -128,6 +131,254 @@ func (twilio *Twilio) GetCallWithContext(ctx context.Context, sid string) (*Voic
 	return voiceResponse, nil, err
 }
 
+// CallFilter narrows down the calls returned by ListCalls.
+// Zero values are ignored.
+type CallFilter struct {
+	To              string
+	From            string
+	ParentCallSid   string
+	Status          CallStatus
+	StartTime       time.Time // only calls started on this day (UTC)
+	StartTimeBefore time.Time // only calls started on or before this time
+	StartTimeAfter  time.Time // only calls started on or after this time
+	EndTime         time.Time // only calls ended on this day (UTC)
+	EndTimeBefore   time.Time // only calls ended on or before this time
+	EndTimeAfter    time.Time // only calls ended on or after this time
+}
+
+func (f *CallFilter) values() url.Values {
+	values := url.Values{}
+	if f == nil {
+		return values
+	}
+	if f.To != "" {
+		values.Set("To", f.To)
+	}
+	if f.From != "" {
+		values.Set("From", f.From)
+	}
+	if f.ParentCallSid != "" {
+		values.Set("ParentCallSid", f.ParentCallSid)
+	}
+	if f.Status != "" {
+		values.Set("Status", string(f.Status))
+	}
+	if !f.StartTime.IsZero() {
+		values.Set("StartTime", f.StartTime.UTC().Format("2006-01-02"))
+	}
+	if !f.StartTimeBefore.IsZero() {
+		values.Set("StartTime<", f.StartTimeBefore.UTC().Format(time.RFC3339))
+	}
+	if !f.StartTimeAfter.IsZero() {
+		values.Set("StartTime>", f.StartTimeAfter.UTC().Format(time.RFC3339))
+	}
+	if !f.EndTime.IsZero() {
+		values.Set("EndTime", f.EndTime.UTC().Format("2006-01-02"))
+	}
+	if !f.EndTimeBefore.IsZero() {
+		values.Set("EndTime<", f.EndTimeBefore.UTC().Format(time.RFC3339))
+	}
+	if !f.EndTimeAfter.IsZero() {
+		values.Set("EndTime>", f.EndTimeAfter.UTC().Format(time.RFC3339))
+	}
+	return values
+}
+
+// ListCalls returns an iterator over the calls of the account matching
+// filter, most recent first. Pages are fetched as they are needed.
+// See https://www.twilio.com/docs/voice/api/call-resource#read-multiple-call-resources
+func (twilio *Twilio) ListCalls(filter *CallFilter, opts *PageOptions) CallIterator {
+	return twilio.ListCallsWithContext(context.Background(), filter, opts)
+}
+
+func (twilio *Twilio) ListCallsWithContext(ctx context.Context, filter *CallFilter, opts *PageOptions) CallIterator {
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Calls.json?" + filter.values().Encode()
+	return CallIterator{twilio.newIterator(ctx, twilioUrl, "calls", opts, func() interface{} { return new(VoiceResponse) })}
+}
+
+// maxCallTwimlLength is the length Twilio accepts for CallRequest.Twiml.
+const maxCallTwimlLength = 4000
+
+// CallRequest describes an outgoing call. Exactly one of Url, Twiml and
+// ApplicationSid must be set.
+// See https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource
+type CallRequest struct {
+	To   string // Required
+	From string // Required
+
+	Url            string // Required unless Twiml or ApplicationSid is set
+	Method         string // Optional
+	Twiml          string // Required unless Url or ApplicationSid is set, e.g. VoiceTwiML.RenderTwiML()
+	ApplicationSid string // Required unless Url or Twiml is set
+
+	FallbackUrl          string   // Optional
+	FallbackMethod       string   // Optional
+	StatusCallback       string   // Optional
+	StatusCallbackMethod string   // Optional
+	StatusCallbackEvent  []string // Optional
+	SendDigits           string   // Optional
+	Timeout              int      // Optional, in seconds
+	TimeLimit            int      // Optional, in seconds
+	CallerId             string   // Optional, only used when calling a Client or SIP endpoint
+	CallReason           string   // Optional, requires Branded Calls
+	Byoc                 string   // Optional
+
+	// SIP authentication is only used when To is a SIP URI.
+	SipAuthUsername string // Optional
+	SipAuthPassword string // Optional
+
+	Record                        *bool    // Optional, Twilio's default is false
+	RecordingChannels             string   // Optional, "mono" or "dual"
+	RecordingTrack                string   // Optional, "inbound", "outbound" or "both"
+	RecordingStatusCallback       string   // Optional
+	RecordingStatusCallbackMethod string   // Optional
+	RecordingStatusCallbackEvent  []string // Optional
+	Trim                          string   // Optional, "trim-silence" or "do-not-trim"
+
+	MachineDetection                   string // Optional, "Enable" or "DetectMessageEnd"
+	MachineDetectionTimeout            int    // Optional
+	MachineDetectionSpeechThreshold    int    // Optional
+	MachineDetectionSpeechEndThreshold int    // Optional
+	MachineDetectionSilenceTimeout     int    // Optional
+	AsyncAmd                           *bool  // Optional
+	AsyncAmdStatusCallback             string // Optional
+	AsyncAmdStatusCallbackMethod       string // Optional
+}
+
+// Validate checks the request for combinations of parameters that Twilio
+// would reject.
+func (r *CallRequest) Validate() error {
+	if r.To == "" || r.From == "" {
+		return errors.New("To and From are required")
+	}
+
+	handlers := 0
+	for _, handler := range []string{r.Url, r.Twiml, r.ApplicationSid} {
+		if handler != "" {
+			handlers++
+		}
+	}
+	if handlers != 1 {
+		return errors.New("exactly one of Url, Twiml and ApplicationSid is required")
+	}
+	if len(r.Twiml) > maxCallTwimlLength {
+		return fmt.Errorf("Twiml can be at most %d characters", maxCallTwimlLength)
+	}
+
+	if (r.SipAuthUsername != "" || r.SipAuthPassword != "") && !strings.HasPrefix(strings.ToLower(r.To), "sip:") {
+		return errors.New("SipAuthUsername and SipAuthPassword require a SIP URI in To")
+	}
+	if r.Timeout < 0 || r.TimeLimit < 0 {
+		return errors.New("Timeout and TimeLimit can't be negative")
+	}
+
+	recording := r.RecordingChannels != "" || r.RecordingTrack != "" || r.RecordingStatusCallback != "" ||
+		r.RecordingStatusCallbackMethod != "" || len(r.RecordingStatusCallbackEvent) > 0 || r.Trim != ""
+	if recording && (r.Record == nil || !*r.Record) {
+		return errors.New("recording options require Record")
+	}
+	switch r.RecordingTrack {
+	case "", "inbound", "outbound", "both":
+	default:
+		return fmt.Errorf("unsupported RecordingTrack %q", r.RecordingTrack)
+	}
+	switch r.Trim {
+	case "", "trim-silence", "do-not-trim":
+	default:
+		return fmt.Errorf("unsupported Trim %q", r.Trim)
+	}
+
+	amd := r.MachineDetectionTimeout != 0 || r.MachineDetectionSpeechThreshold != 0 ||
+		r.MachineDetectionSpeechEndThreshold != 0 || r.MachineDetectionSilenceTimeout != 0 ||
+		r.AsyncAmd != nil || r.AsyncAmdStatusCallback != "" || r.AsyncAmdStatusCallbackMethod != ""
+	switch r.MachineDetection {
+	case "Enable", "DetectMessageEnd":
+	case "":
+		if amd {
+			return errors.New("answering machine detection options require MachineDetection")
+		}
+	default:
+		return fmt.Errorf("unsupported MachineDetection %q", r.MachineDetection)
+	}
+	return nil
+}
+
+func (r *CallRequest) formValues() url.Values {
+	formValues := url.Values{}
+	formValues.Set("To", r.To)
+	formValues.Set("From", r.From)
+
+	set := func(key, value string) {
+		if value != "" {
+			formValues.Set(key, value)
+		}
+	}
+	setInt := func(key string, value int) {
+		if value != 0 {
+			formValues.Set(key, strconv.Itoa(value))
+		}
+	}
+	setBool := func(key string, value *bool) {
+		if value != nil {
+			formValues.Set(key, strconv.FormatBool(*value))
+		}
+	}
+
+	set("Url", r.Url)
+	set("Method", r.Method)
+	set("Twiml", r.Twiml)
+	set("ApplicationSid", r.ApplicationSid)
+	set("FallbackUrl", r.FallbackUrl)
+	set("FallbackMethod", r.FallbackMethod)
+	set("StatusCallback", r.StatusCallback)
+	set("StatusCallbackMethod", r.StatusCallbackMethod)
+	for _, event := range r.StatusCallbackEvent {
+		formValues.Add("StatusCallbackEvent", event)
+	}
+	set("SendDigits", r.SendDigits)
+	setInt("Timeout", r.Timeout)
+	setInt("TimeLimit", r.TimeLimit)
+	set("CallerId", r.CallerId)
+	set("CallReason", r.CallReason)
+	set("Byoc", r.Byoc)
+	set("SipAuthUsername", r.SipAuthUsername)
+	set("SipAuthPassword", r.SipAuthPassword)
+
+	setBool("Record", r.Record)
+	set("RecordingChannels", r.RecordingChannels)
+	set("RecordingTrack", r.RecordingTrack)
+	set("RecordingStatusCallback", r.RecordingStatusCallback)
+	set("RecordingStatusCallbackMethod", r.RecordingStatusCallbackMethod)
+	for _, event := range r.RecordingStatusCallbackEvent {
+		formValues.Add("RecordingStatusCallbackEvent", event)
+	}
+	set("Trim", r.Trim)
+
+	set("MachineDetection", r.MachineDetection)
+	setInt("MachineDetectionTimeout", r.MachineDetectionTimeout)
+	setInt("MachineDetectionSpeechThreshold", r.MachineDetectionSpeechThreshold)
+	setInt("MachineDetectionSpeechEndThreshold", r.MachineDetectionSpeechEndThreshold)
+	setInt("MachineDetectionSilenceTimeout", r.MachineDetectionSilenceTimeout)
+	setBool("AsyncAmd", r.AsyncAmd)
+	set("AsyncAmdStatusCallback", r.AsyncAmdStatusCallback)
+	set("AsyncAmdStatusCallbackMethod", r.AsyncAmdStatusCallbackMethod)
+
+	return formValues
+}
+
+// PlaceCall validates req and uses Twilio to place a voice call.
+// See https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource
+func (twilio *Twilio) PlaceCall(req *CallRequest) (*VoiceResponse, *Exception, error) {
+	return twilio.PlaceCallWithContext(context.Background(), req)
+}
+
+func (twilio *Twilio) PlaceCallWithContext(ctx context.Context, req *CallRequest) (*VoiceResponse, *Exception, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return twilio.voicePost(ctx, "Calls.json", req.formValues())
+}
+
 // Place a voice call with a list of callbacks specified.
 func (twilio *Twilio) CallWithUrlCallbacks(from, to string, callbackParameters *CallbackParameters) (*VoiceResponse, *Exception, error) {
 	return twilio.CallWithUrlCallbacksWithContext(context.Background(), from, to, callbackParameters)
@@ -245,6 +496,137 @@ func (twilio *Twilio) CallUpdateWithContext(ctx context.Context, callSid string,
 	return twilio.voicePost(ctx, "Calls/"+callSid+".json", formValues)
 }
 
+// RedirectCall transfers control of a live call to the TwiML at callbackUrl,
+// fetched with method ("GET" or "POST", POST if empty).
+// See https://www.twilio.com/docs/voice/tutorials/how-to-modify-calls-in-progress
+func (twilio *Twilio) RedirectCall(callSid, callbackUrl, method string) (*VoiceResponse, *Exception, error) {
+	return twilio.RedirectCallWithContext(context.Background(), callSid, callbackUrl, method)
+}
+
+func (twilio *Twilio) RedirectCallWithContext(ctx context.Context, callSid, callbackUrl, method string) (*VoiceResponse, *Exception, error) {
+	if callbackUrl == "" {
+		return nil, nil, errors.New("a URL is required to redirect a call")
+	}
+	if method != "" && method != http.MethodGet && method != http.MethodPost {
+		return nil, nil, fmt.Errorf("unsupported method %q, must be GET or POST", method)
+	}
+
+	formValues := url.Values{}
+	formValues.Set("Url", callbackUrl)
+	if method != "" {
+		formValues.Set("Method", method)
+	}
+	return twilio.CallUpdateWithContext(ctx, callSid, formValues)
+}
+
+// UpdateCallTwiML replaces the instructions of a live call with twiml, e.g.
+// the output of VoiceTwiML.RenderTwiML.
+func (twilio *Twilio) UpdateCallTwiML(callSid, twiml string) (*VoiceResponse, *Exception, error) {
+	return twilio.UpdateCallTwiMLWithContext(context.Background(), callSid, twiml)
+}
+
+func (twilio *Twilio) UpdateCallTwiMLWithContext(ctx context.Context, callSid, twiml string) (*VoiceResponse, *Exception, error) {
+	if twiml == "" {
+		return nil, nil, errors.New("TwiML is required to update a call")
+	}
+	if len(twiml) > maxCallTwimlLength {
+		return nil, nil, fmt.Errorf("Twiml can be at most %d characters", maxCallTwimlLength)
+	}
+
+	formValues := url.Values{}
+	formValues.Set("Twiml", twiml)
+	return twilio.CallUpdateWithContext(ctx, callSid, formValues)
+}
+
+// SetCallStatusCallback changes the URL that receives the status callbacks
+// of a call, requested with method ("GET" or "POST", POST if empty).
+func (twilio *Twilio) SetCallStatusCallback(callSid, callbackUrl, method string) (*VoiceResponse, *Exception, error) {
+	return twilio.SetCallStatusCallbackWithContext(context.Background(), callSid, callbackUrl, method)
+}
+
+func (twilio *Twilio) SetCallStatusCallbackWithContext(ctx context.Context, callSid, callbackUrl, method string) (*VoiceResponse, *Exception, error) {
+	if callbackUrl == "" {
+		return nil, nil, errors.New("a status callback URL is required")
+	}
+	if method != "" && method != http.MethodGet && method != http.MethodPost {
+		return nil, nil, fmt.Errorf("unsupported method %q, must be GET or POST", method)
+	}
+
+	formValues := url.Values{}
+	formValues.Set("StatusCallback", callbackUrl)
+	if method != "" {
+		formValues.Set("StatusCallbackMethod", method)
+	}
+	return twilio.CallUpdateWithContext(ctx, callSid, formValues)
+}
+
+// HangupCall ends a call. A call that hasn't been answered yet is canceled.
+// The call is fetched first and an error is returned without updating it if
+// it has already ended.
+func (twilio *Twilio) HangupCall(callSid string) (*VoiceResponse, *Exception, error) {
+	return twilio.HangupCallWithContext(context.Background(), callSid)
+}
+
+func (twilio *Twilio) HangupCallWithContext(ctx context.Context, callSid string) (*VoiceResponse, *Exception, error) {
+	return twilio.fetchAndUpdateCallStatus(ctx, callSid, CallStatusCompleted)
+}
+
+// CancelCall cancels a call that is queued or ringing. The call is fetched
+// first and an error is returned without updating it if it has already been
+// answered. Twilio may still answer with an ErrorInvalidCallState exception
+// if the call is answered in the meantime.
+func (twilio *Twilio) CancelCall(callSid string) (*VoiceResponse, *Exception, error) {
+	return twilio.CancelCallWithContext(context.Background(), callSid)
+}
+
+func (twilio *Twilio) CancelCallWithContext(ctx context.Context, callSid string) (*VoiceResponse, *Exception, error) {
+	return twilio.fetchAndUpdateCallStatus(ctx, callSid, CallStatusCanceled)
+}
+
+// CanTransitionTo reports whether a call in status s can be moved to target
+// through the API. Only canceled, for calls that haven't been answered yet,
+// and completed, for calls that haven't ended, can be requested.
+// See https://www.twilio.com/docs/voice/api/call-resource#update-a-call-resource
+func (s CallStatus) CanTransitionTo(target CallStatus) bool {
+	switch target {
+	case CallStatusCanceled:
+		return s == CallStatusQueued || s == CallStatusInitiated || s == CallStatusRinging
+	case CallStatusCompleted:
+		return !s.IsTerminal()
+	}
+	return false
+}
+
+// UpdateCallStatus moves a call from its known current status, e.g. taken
+// from a CallStatusWebhook, to target without a request to Twilio when the
+// transition isn't allowed.
+func (twilio *Twilio) UpdateCallStatus(callSid string, current, target CallStatus) (*VoiceResponse, *Exception, error) {
+	return twilio.UpdateCallStatusWithContext(context.Background(), callSid, current, target)
+}
+
+func (twilio *Twilio) UpdateCallStatusWithContext(ctx context.Context, callSid string, current, target CallStatus) (*VoiceResponse, *Exception, error) {
+	if !current.CanTransitionTo(target) {
+		return nil, nil, fmt.Errorf("can't update a %s call to %s", current, target)
+	}
+	return twilio.updateCallStatus(ctx, callSid, target)
+}
+
+// fetchAndUpdateCallStatus moves a call to target after checking the
+// transition from its current status.
+func (twilio *Twilio) fetchAndUpdateCallStatus(ctx context.Context, callSid string, target CallStatus) (*VoiceResponse, *Exception, error) {
+	call, exception, err := twilio.GetCallWithContext(ctx, callSid)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return twilio.UpdateCallStatusWithContext(ctx, callSid, CallStatus(call.Status), target)
+}
+
+func (twilio *Twilio) updateCallStatus(ctx context.Context, callSid string, status CallStatus) (*VoiceResponse, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("Status", string(status))
+	return twilio.CallUpdateWithContext(ctx, callSid, formValues)
+}
+
 // This is a private method that has the common bits for placing or updating a voice call.
 func (twilio *Twilio) voicePost(ctx context.Context, resourcePath string, formValues url.Values) (*VoiceResponse, *Exception, error) {
 	var voiceResponse *VoiceResponse
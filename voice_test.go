@@ -0,0 +1,198 @@
+package gotwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCallRequestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   CallRequest
+		valid bool
+	}{
+		{"url", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com/twiml"}, true},
+		{"twiml", CallRequest{To: "+19135551234", From: "+15005550006", Twiml: "<Response><Say>hi</Say></Response>"}, true},
+		{"no handler", CallRequest{To: "+19135551234", From: "+15005550006"}, false},
+		{"url and twiml", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com", Twiml: "<Response/>"}, false},
+		{"sip auth without sip", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com", SipAuthUsername: "alice"}, false},
+		{"sip auth", CallRequest{To: "sip:alice@example.com", From: "+15005550006", Url: "https://example.com", SipAuthUsername: "alice"}, true},
+		{"recording without record", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com", RecordingTrack: "both"}, false},
+		{"recording", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com", Record: NewBoolean(true), RecordingTrack: "both", Trim: "trim-silence"}, true},
+		{"amd without detection", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com", AsyncAmd: NewBoolean(true)}, false},
+		{"invalid detection", CallRequest{To: "+19135551234", From: "+15005550006", Url: "https://example.com", MachineDetection: "yes"}, false},
+	}
+	for _, test := range tests {
+		err := test.req.Validate()
+		if (err == nil) != test.valid {
+			t.Errorf("%s: expected valid=%t, got %v", test.name, test.valid, err)
+		}
+	}
+}
+
+func TestPlaceCallTwiml(t *testing.T) {
+	twiml := "<Response><Say>Your order has shipped.</Say></Response>"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/Accounts/AC1/Calls.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.PostForm.Get("Twiml") != twiml || r.PostForm.Get("Url") != "" {
+			t.Errorf("Unexpected handler parameters: %v", r.PostForm)
+		}
+		if _, ok := r.PostForm["Record"]; ok {
+			t.Errorf("Record should not be sent unless set: %v", r.PostForm)
+		}
+		if r.PostForm.Get("MachineDetection") != "Enable" || r.PostForm.Get("AsyncAmd") != "true" || r.PostForm.Get("TimeLimit") != "60" {
+			t.Errorf("Unexpected parameters: %v", r.PostForm)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"sid": "CA1", "status": "queued"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	res, exc, err := twilio.PlaceCall(&CallRequest{
+		To:               "+19135551234",
+		From:             "+15005550006",
+		Twiml:            twiml,
+		TimeLimit:        60,
+		MachineDetection: "Enable",
+		AsyncAmd:         NewBoolean(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exc != nil {
+		t.Fatal(exc)
+	}
+	if res.Sid != "CA1" {
+		t.Errorf("Unexpected call: %+v", res)
+	}
+}
+
+func TestCallUpdates(t *testing.T) {
+	var last map[string][]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/Accounts/AC1/Calls/CA1.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.Method == http.MethodPost {
+			last = r.PostForm
+		}
+		fmt.Fprint(w, `{"sid": "CA1", "status": "in-progress"}`)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	if _, _, err := twilio.RedirectCall("CA1", "https://example.com/transfer", "GET"); err != nil {
+		t.Fatal(err)
+	}
+	if last["Url"][0] != "https://example.com/transfer" || last["Method"][0] != "GET" {
+		t.Errorf("Unexpected redirect parameters: %v", last)
+	}
+
+	if _, _, err := twilio.HangupCall("CA1"); err != nil {
+		t.Fatal(err)
+	}
+	if last["Status"][0] != "completed" {
+		t.Errorf("Unexpected hangup parameters: %v", last)
+	}
+
+	if _, _, err := twilio.SetCallStatusCallback("CA1", "https://example.com/status", ""); err != nil {
+		t.Fatal(err)
+	}
+	if last["StatusCallback"][0] != "https://example.com/status" {
+		t.Errorf("Unexpected callback parameters: %v", last)
+	}
+
+	last = nil
+	if _, _, err := twilio.RedirectCall("CA1", "https://example.com", "PUT"); err == nil {
+		t.Error("expected an error for an unsupported method")
+	}
+	if _, _, err := twilio.UpdateCallStatus("CA1", CallStatusInProgress, CallStatusCanceled); err == nil {
+		t.Error("expected an error when canceling an answered call")
+	}
+	if _, _, err := twilio.CancelCall("CA1"); err == nil {
+		t.Error("expected an error when canceling a call that was answered")
+	}
+	if last != nil {
+		t.Errorf("invalid updates should not be sent: %v", last)
+	}
+}
+
+func TestCallStatusCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		current, target CallStatus
+		allowed         bool
+	}{
+		{CallStatusRinging, CallStatusCanceled, true},
+		{CallStatusInProgress, CallStatusCanceled, false},
+		{CallStatusInProgress, CallStatusCompleted, true},
+		{CallStatusQueued, CallStatusCompleted, true},
+		{CallStatusCompleted, CallStatusCompleted, false},
+		{CallStatusInProgress, CallStatusBusy, false},
+	}
+	for _, test := range tests {
+		if got := test.current.CanTransitionTo(test.target); got != test.allowed {
+			t.Errorf("%s -> %s: expected %t, got %t", test.current, test.target, test.allowed, got)
+		}
+	}
+}
+
+func TestListCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("Status") != "completed" || q.Get("StartTime>") != "2020-01-01T00:00:00Z" || q.Get("StartTime<") != "2020-01-02T00:00:00Z" {
+			t.Errorf("Unexpected filters: %s", r.URL.RawQuery)
+		}
+		if r.URL.Path != "/Accounts/AC1/Calls.json" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, testListCallsResponse)
+	}))
+	defer srv.Close()
+
+	twilio := NewTwilioClient("AC1", "")
+	twilio.BaseUrl = srv.URL
+
+	it := twilio.ListCalls(&CallFilter{
+		Status:          CallStatusCompleted,
+		StartTimeAfter:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		StartTimeBefore: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	var durations []int
+	for it.Next() {
+		durations = append(durations, it.Value().Duration)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(durations) != 2 || durations[0] != 15 || durations[1] != 0 {
+		t.Errorf("Unexpected calls: %v", durations)
+	}
+}
+
+const testListCallsResponse = `
+{
+  "calls": [
+    {"sid": "CA1", "status": "completed", "duration": "15", "price": "-0.02"},
+    {"sid": "CA2", "status": "completed", "duration": null, "price": null}
+  ],
+  "end": 1,
+  "next_page_uri": null,
+  "page": 0,
+  "page_size": 50,
+  "start": 0,
+  "uri": "/2010-04-01/Accounts/AC1/Calls.json?Status=completed&PageSize=50&Page=0"
+}
+`
@@ -0,0 +1,280 @@
+package gotwilio
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// TwiMLRenderer is implemented by TwiML documents that can be written back
+// to Twilio in response to a webhook.
+type TwiMLRenderer interface {
+	RenderTwiML() (string, error)
+}
+
+// WebhookStatus can be returned as the error of a webhook callback to reply
+// with a specific HTTP status code, e.g. WebhookStatus(http.StatusForbidden)
+// to block a Proxy interaction from an intercept callback.
+type WebhookStatus int
+
+func (s WebhookStatus) Error() string {
+	return http.StatusText(int(s))
+}
+
+// WebhookHandler is an http.Handler that receives Twilio webhooks. For each
+// request it validates the X-Twilio-Signature header, decodes the parameters
+// into the typed webhook struct registered for the request path, calls the
+// registered callback and writes its TwiML, or an empty response, back.
+//
+// Requests without a valid signature are rejected with 403 Forbidden and
+// requests to an unregistered path with 404 Not Found. A callback error is
+// answered with 500 Internal Server Error unless it is a WebhookStatus.
+type WebhookHandler struct {
+	Twilio *Twilio
+
+	// BaseURL is the public scheme and host (and optional path prefix) that
+	// Twilio uses to reach the handler, e.g. "https://example.com". It is
+	// needed to compute the signature when the handler runs behind a proxy
+	// or load balancer. If empty it is derived from the request.
+	BaseURL string
+
+	// TrustForwardedHeaders makes the handler derive the scheme and host
+	// from the X-Forwarded-Proto and X-Forwarded-Host headers when BaseURL
+	// is empty. Only enable it behind a proxy that overwrites these headers,
+	// otherwise a signed request can be replayed against another host.
+	TrustForwardedHeaders bool
+
+	// AuthTokens, when set, are the auth tokens accepted for signatures
+	// instead of Twilio.AuthToken. List both the primary and the secondary
+	// token while rotating credentials.
+	AuthTokens []string
+
+	routes map[string]*webhookRoute
+}
+
+type webhookRoute struct {
+	twiml   bool
+	newHook func() interface{}
+	handle  func(r *http.Request, hook interface{}) (TwiMLRenderer, error)
+}
+
+// NewWebhookHandler returns a WebhookHandler validating signatures with the
+// credentials of twilio.
+func NewWebhookHandler(twilio *Twilio, baseURL string) *WebhookHandler {
+	return &WebhookHandler{
+		Twilio:  twilio,
+		BaseURL: baseURL,
+		routes:  make(map[string]*webhookRoute),
+	}
+}
+
+func (h *WebhookHandler) register(path string, route *webhookRoute) {
+	if h.routes == nil {
+		h.routes = make(map[string]*webhookRoute)
+	}
+	h.routes[path] = route
+}
+
+// HandleSMS registers fn for inbound messages posted to path.
+func (h *WebhookHandler) HandleSMS(path string, fn func(r *http.Request, hook *SMSWebhook) (TwiMLRenderer, error)) {
+	h.register(path, &webhookRoute{
+		twiml:   true,
+		newHook: func() interface{} { return new(SMSWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return fn(r, hook.(*SMSWebhook))
+		},
+	})
+}
+
+// HandleMessageStatus registers fn for message status callbacks posted to path.
+func (h *WebhookHandler) HandleMessageStatus(path string, fn func(r *http.Request, hook *MessageStatusWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(MessageStatusWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*MessageStatusWebhook))
+		},
+	})
+}
+
+// HandleVoice registers fn for incoming calls and verb actions posted to path.
+func (h *WebhookHandler) HandleVoice(path string, fn func(r *http.Request, hook *VoiceWebhook) (TwiMLRenderer, error)) {
+	h.register(path, &webhookRoute{
+		twiml:   true,
+		newHook: func() interface{} { return new(VoiceWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return fn(r, hook.(*VoiceWebhook))
+		},
+	})
+}
+
+// HandleCallStatus registers fn for call status callbacks posted to path.
+func (h *WebhookHandler) HandleCallStatus(path string, fn func(r *http.Request, hook *CallStatusWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(CallStatusWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*CallStatusWebhook))
+		},
+	})
+}
+
+// HandleRecordingStatus registers fn for recording status callbacks posted to path.
+func (h *WebhookHandler) HandleRecordingStatus(path string, fn func(r *http.Request, hook *RecordingStatusWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(RecordingStatusWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*RecordingStatusWebhook))
+		},
+	})
+}
+
+// HandleAsyncAmd registers fn for asynchronous answering machine detection
+// results posted to path.
+func (h *WebhookHandler) HandleAsyncAmd(path string, fn func(r *http.Request, hook *AsyncAmdWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(AsyncAmdWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*AsyncAmdWebhook))
+		},
+	})
+}
+
+// HandleConferenceStatus registers fn for conference status callbacks posted
+// to path, e.g. to feed a ConferenceTracker.
+func (h *WebhookHandler) HandleConferenceStatus(path string, fn func(r *http.Request, hook *ConferenceStatusWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(ConferenceStatusWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*ConferenceStatusWebhook))
+		},
+	})
+}
+
+// HandleIncomingFax registers fn for incoming faxes posted to path. fn must
+// return a FaxResponse with Receive for the fax to be received.
+func (h *WebhookHandler) HandleIncomingFax(path string, fn func(r *http.Request, hook *IncomingFaxWebhook) (TwiMLRenderer, error)) {
+	h.register(path, &webhookRoute{
+		twiml:   true,
+		newHook: func() interface{} { return new(IncomingFaxWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return fn(r, hook.(*IncomingFaxWebhook))
+		},
+	})
+}
+
+// HandleFaxStatus registers fn for fax status callbacks posted to path.
+func (h *WebhookHandler) HandleFaxStatus(path string, fn func(r *http.Request, hook *FaxStatusWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(FaxStatusWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*FaxStatusWebhook))
+		},
+	})
+}
+
+// HandleProxyCallback registers fn for Proxy interaction callbacks posted to path.
+func (h *WebhookHandler) HandleProxyCallback(path string, fn func(r *http.Request, hook *ProxyCallbackWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(ProxyCallbackWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*ProxyCallbackWebhook))
+		},
+	})
+}
+
+// HandleProxyIntercept registers fn for Proxy intercept callbacks posted to
+// path. Return WebhookStatus(http.StatusForbidden) to block the interaction.
+func (h *WebhookHandler) HandleProxyIntercept(path string, fn func(r *http.Request, hook *ProxyInterceptCallbackWebhook) error) {
+	h.register(path, &webhookRoute{
+		newHook: func() interface{} { return new(ProxyInterceptCallbackWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return nil, fn(r, hook.(*ProxyInterceptCallbackWebhook))
+		},
+	})
+}
+
+// HandleProxyOutOfSession registers fn for Proxy out of session callbacks
+// posted to path.
+func (h *WebhookHandler) HandleProxyOutOfSession(path string, fn func(r *http.Request, hook *ProxyOutOfSessionCallbackWebhook) (TwiMLRenderer, error)) {
+	h.register(path, &webhookRoute{
+		twiml:   true,
+		newHook: func() interface{} { return new(ProxyOutOfSessionCallbackWebhook) },
+		handle: func(r *http.Request, hook interface{}) (TwiMLRenderer, error) {
+			return fn(r, hook.(*ProxyOutOfSessionCallbackWebhook))
+		},
+	})
+}
+
+// publicBaseURL returns the scheme and host Twilio used to reach the handler.
+func (h *WebhookHandler) publicBaseURL(r *http.Request) string {
+	if h.BaseURL != "" {
+		return strings.TrimSuffix(h.BaseURL, "/")
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" && h.TrustForwardedHeaders {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+
+	host := r.Host
+	if fwd := r.Header.Get("X-Forwarded-Host"); fwd != "" && h.TrustForwardedHeaders {
+		host = strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+
+	return scheme + "://" + host
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, ok := h.routes[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	authTokens := h.AuthTokens
+	if len(authTokens) == 0 {
+		authTokens = []string{h.Twilio.AuthToken}
+	}
+
+	match, err := checkSignature(r, h.publicBaseURL(r)+r.URL.RequestURI(), authTokens)
+	if err != nil || match < 0 {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	hook := route.newHook()
+	if err := DecodeWebhook(r.Form, hook); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	twiml, err := route.handle(r, hook)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if s, ok := err.(WebhookStatus); ok {
+			status = int(s)
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	if !route.twiml {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// An empty Response tells Twilio there is nothing more to do.
+	body := xml.Header + "<Response></Response>"
+	if twiml != nil {
+		if body, err = twiml.RenderTwiML(); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	io.WriteString(w, body)
+}
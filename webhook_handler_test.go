@@ -0,0 +1,148 @@
+package gotwilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func newSignedWebhookRequest(t *testing.T, twilio *Twilio, target string, form url.Values) *http.Request {
+	sig, err := twilio.GenerateSignature(target, form)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest("POST", target, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("X-Twilio-Signature", string(sig))
+	return r
+}
+
+func TestWebhookHandlerSMS(t *testing.T) {
+	twilio := NewTwilioClient("AC1", testAuthToken)
+	h := NewWebhookHandler(twilio, "https://example.com")
+
+	var received *SMSWebhook
+	h.HandleSMS("/sms", func(r *http.Request, hook *SMSWebhook) (TwiMLRenderer, error) {
+		received = hook
+		reply := "Thanks!"
+		mr := new(MessagingResponse)
+		_, err := mr.Message(&TWiMLSmsMessage{Body: &reply})
+		return mr, err
+	})
+
+	form := url.Values{"From": {"+19135551234"}, "Body": {"hello"}}
+	r := newSignedWebhookRequest(t, twilio, "https://example.com/sms", form)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", w.Code)
+	}
+	if received == nil || received.Body != "hello" || received.From != "+19135551234" {
+		t.Fatalf("Unexpected webhook: %+v", received)
+	}
+	if !strings.Contains(w.Body.String(), "<Body>Thanks!</Body>") {
+		t.Errorf("Expected TwiML reply, got %s", w.Body.String())
+	}
+}
+
+func TestWebhookHandlerIncomingFax(t *testing.T) {
+	twilio := NewTwilioClient("AC1", testAuthToken)
+	h := NewWebhookHandler(twilio, "https://example.com")
+
+	var received *IncomingFaxWebhook
+	h.HandleIncomingFax("/fax", func(r *http.Request, hook *IncomingFaxWebhook) (TwiMLRenderer, error) {
+		received = hook
+		return new(FaxResponse).Receive(&TWiMLReceive{Action: "/fax/received", MediaType: "application/pdf"})
+	})
+
+	form := url.Values{"FaxSid": {"FX1"}, "From": {"+19135551234"}, "FaxStatus": {"receiving"}}
+	r := newSignedWebhookRequest(t, twilio, "https://example.com/fax", form)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", w.Code)
+	}
+	if received == nil || received.FaxSid != "FX1" || received.FaxStatus != FaxStatusReceiving {
+		t.Fatalf("Unexpected webhook: %+v", received)
+	}
+	if !strings.Contains(w.Body.String(), `<Receive action="/fax/received" mediaType="application/pdf">`) {
+		t.Errorf("Expected Receive TwiML, got %s", w.Body.String())
+	}
+}
+
+func TestWebhookHandlerRejectsUnsigned(t *testing.T) {
+	twilio := NewTwilioClient("AC1", testAuthToken)
+	h := NewWebhookHandler(twilio, "https://example.com")
+	h.HandleCallStatus("/status", func(r *http.Request, hook *CallStatusWebhook) error {
+		t.Error("Callback should not be called")
+		return nil
+	})
+
+	form := url.Values{"CallSid": {"CA1"}}
+
+	r := httptest.NewRequest("POST", "https://example.com/status", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected 403 for unsigned request, got %d", w.Code)
+	}
+
+	// Signed for a different host than the one configured.
+	r = newSignedWebhookRequest(t, twilio, "https://attacker.example.com/status", form)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected 403 for bad signature, got %d", w.Code)
+	}
+
+	r = newSignedWebhookRequest(t, twilio, "https://example.com/unknown", form)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown path, got %d", w.Code)
+	}
+}
+
+func TestWebhookHandlerForwardedHeaders(t *testing.T) {
+	twilio := NewTwilioClient("AC1", testAuthToken)
+	h := NewWebhookHandler(twilio, "")
+
+	blocked := false
+	h.HandleProxyIntercept("/intercept", func(r *http.Request, hook *ProxyInterceptCallbackWebhook) error {
+		blocked = hook.InteractionSid == "KI1"
+		return WebhookStatus(http.StatusForbidden)
+	})
+
+	form := url.Values{"interactionSid": {"KI1"}}
+	newRequest := func() *http.Request {
+		r := newSignedWebhookRequest(t, twilio, "https://public.example.com/intercept", form)
+		r.Host = "internal:8080"
+		r.Header.Set("X-Forwarded-Proto", "https")
+		r.Header.Set("X-Forwarded-Host", "public.example.com")
+		return r
+	}
+
+	// the headers are only honored when the handler is configured to
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest())
+	if blocked || w.Code != http.StatusForbidden {
+		t.Fatalf("Expected forwarded headers to be ignored, got %d", w.Code)
+	}
+
+	h.TrustForwardedHeaders = true
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest())
+
+	if !blocked {
+		t.Fatal("Expected intercept callback to be called")
+	}
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected callback status 403, got %d", w.Code)
+	}
+}
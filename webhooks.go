@@ -220,3 +220,347 @@ type SMSWebhook struct {
 	MediaContentType10 string `json:"MediaContentType10"`
 	MediaUrl10         string `json:"MediaUrl10"`
 }
+
+// MediaURLs returns the non-empty MediaUrl{N} fields in order. Use
+// Twilio.DownloadMediaURL to fetch their content.
+func (w *SMSWebhook) MediaURLs() []string {
+	all := []string{
+		w.MediaUrl0, w.MediaUrl1, w.MediaUrl2, w.MediaUrl3, w.MediaUrl4, w.MediaUrl5,
+		w.MediaUrl6, w.MediaUrl7, w.MediaUrl8, w.MediaUrl9, w.MediaUrl10,
+	}
+
+	var urls []string
+	for _, u := range all {
+		if u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// MessageStatus is the delivery status of a message.
+// See https://www.twilio.com/docs/sms/api/message-resource#message-status-values
+type MessageStatus string
+
+const (
+	MessageStatusAccepted    MessageStatus = "accepted"
+	MessageStatusScheduled   MessageStatus = "scheduled"
+	MessageStatusCanceled    MessageStatus = "canceled"
+	MessageStatusQueued      MessageStatus = "queued"
+	MessageStatusSending     MessageStatus = "sending"
+	MessageStatusSent        MessageStatus = "sent"
+	MessageStatusFailed      MessageStatus = "failed"
+	MessageStatusDelivered   MessageStatus = "delivered"
+	MessageStatusUndelivered MessageStatus = "undelivered"
+	MessageStatusReceiving   MessageStatus = "receiving"
+	MessageStatusReceived    MessageStatus = "received"
+	MessageStatusRead        MessageStatus = "read"
+)
+
+// IsTerminal reports whether no further status changes are expected for the
+// message. WhatsApp messages may still move from delivered to read.
+func (s MessageStatus) IsTerminal() bool {
+	switch s {
+	case MessageStatusCanceled,
+		MessageStatusFailed,
+		MessageStatusDelivered,
+		MessageStatusUndelivered,
+		MessageStatusReceived,
+		MessageStatusRead:
+		return true
+	}
+	return false
+}
+
+// https://www.twilio.com/docs/sms/outbound-message-logging#status-callback-requests
+// MessageStatusWebhook is posted to the StatusCallback of an outbound
+// message every time its status changes.
+type MessageStatusWebhook struct {
+	AccountSid          string `form:"AccountSid"`
+	APIVersion          string `form:"ApiVersion"`
+	MessagingServiceSid string `form:"MessagingServiceSid"`
+
+	MessageSid    string        `form:"MessageSid"`
+	SmsSid        string        `form:"SmsSid"`
+	MessageStatus MessageStatus `form:"MessageStatus"`
+	SmsStatus     MessageStatus `form:"SmsStatus"`
+
+	To   string `form:"To"`
+	From string `form:"From"`
+
+	ErrorCode    ExceptionCode `form:"ErrorCode"`
+	ErrorMessage string        `form:"ErrorMessage"`
+
+	// Carrier delivery receipt completion time formatted as YYMMDDhhmm,
+	// only sent for delivered and undelivered messages.
+	RawDlrDoneDate string `form:"RawDlrDoneDate"`
+
+	// WhatsApp and other channels
+	ChannelInstallSid string `form:"ChannelInstallSid"`
+	ChannelPrefix     string `form:"ChannelPrefix"`
+	ChannelToAddress  string `form:"ChannelToAddress"`
+	EventType         string `form:"EventType"`
+}
+
+// IsTerminal reports whether this is the last status callback expected for
+// the message.
+func (w *MessageStatusWebhook) IsTerminal() bool {
+	return w.MessageStatus.IsTerminal()
+}
+
+// RawDlrDoneDateAsTime returns MessageStatusWebhook.RawDlrDoneDate as a
+// time.Time object in UTC instead of a string.
+func (w *MessageStatusWebhook) RawDlrDoneDateAsTime() (time.Time, error) {
+	return time.Parse("0601021504", w.RawDlrDoneDate)
+}
+
+// CallStatus is the status of a voice call.
+// See https://www.twilio.com/docs/voice/api/call-resource#call-status-values
+type CallStatus string
+
+const (
+	CallStatusQueued     CallStatus = "queued"
+	CallStatusInitiated  CallStatus = "initiated"
+	CallStatusRinging    CallStatus = "ringing"
+	CallStatusInProgress CallStatus = "in-progress"
+	CallStatusCompleted  CallStatus = "completed"
+	CallStatusBusy       CallStatus = "busy"
+	CallStatusFailed     CallStatus = "failed"
+	CallStatusNoAnswer   CallStatus = "no-answer"
+	CallStatusCanceled   CallStatus = "canceled"
+)
+
+// IsTerminal reports whether the call has ended.
+func (s CallStatus) IsTerminal() bool {
+	switch s {
+	case CallStatusCompleted,
+		CallStatusBusy,
+		CallStatusFailed,
+		CallStatusNoAnswer,
+		CallStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// https://www.twilio.com/docs/voice/twiml#request-parameters
+// VoiceWebhook is sent to the voice URL of a phone number or application
+// when a call is received or placed, and to the action URL of verbs such as
+// Gather. If your server responds with valid TwiML, it will be executed.
+type VoiceWebhook struct {
+	AccountSid    string     `form:"AccountSid"`
+	APIVersion    string     `form:"ApiVersion"`
+	CallSid       string     `form:"CallSid"`
+	ParentCallSid string     `form:"ParentCallSid"`
+	CallStatus    CallStatus `form:"CallStatus"`
+	Direction     string     `form:"Direction"`
+	ForwardedFrom string     `form:"ForwardedFrom"`
+	CallerName    string     `form:"CallerName"`
+	CallToken     string     `form:"CallToken"`
+
+	To          string `form:"To"`
+	ToCity      string `form:"ToCity"`
+	ToState     string `form:"ToState"`
+	ToZip       string `form:"ToZip"`
+	ToCountry   string `form:"ToCountry"`
+	From        string `form:"From"`
+	FromCity    string `form:"FromCity"`
+	FromState   string `form:"FromState"`
+	FromZip     string `form:"FromZip"`
+	FromCountry string `form:"FromCountry"`
+
+	Caller        string `form:"Caller"`
+	CallerCity    string `form:"CallerCity"`
+	CallerState   string `form:"CallerState"`
+	CallerZip     string `form:"CallerZip"`
+	CallerCountry string `form:"CallerCountry"`
+
+	Called        string `form:"Called"`
+	CalledCity    string `form:"CalledCity"`
+	CalledState   string `form:"CalledState"`
+	CalledZip     string `form:"CalledZip"`
+	CalledCountry string `form:"CalledCountry"`
+
+	// Gather specific
+	Digits       string `form:"Digits"`
+	SpeechResult string `form:"SpeechResult"`
+	Confidence   string `form:"Confidence"`
+
+	AddOns string `form:"AddOns"`
+}
+
+// https://www.twilio.com/docs/voice/api/call-resource#statuscallback
+// CallStatusWebhook is posted to the StatusCallback of a call for each of
+// the StatusCallbackEvent values it subscribed to.
+type CallStatusWebhook struct {
+	VoiceWebhook
+
+	Timestamp      string `form:"Timestamp"`
+	CallbackSource string `form:"CallbackSource"`
+	SequenceNumber int    `form:"SequenceNumber"`
+	CallDuration   int    `form:"CallDuration"`
+	Duration       int    `form:"Duration"`
+
+	SipResponseCode   int    `form:"SipResponseCode"`
+	RecordingURL      string `form:"RecordingUrl"`
+	RecordingSid      string `form:"RecordingSid"`
+	RecordingDuration int    `form:"RecordingDuration"`
+
+	AnsweredBy               string `form:"AnsweredBy"`
+	MachineDetectionDuration int    `form:"MachineDetectionDuration"`
+}
+
+// IsTerminal reports whether this is the final status callback of the call.
+func (w *CallStatusWebhook) IsTerminal() bool {
+	return w.CallStatus.IsTerminal()
+}
+
+// TimestampAsTime returns CallStatusWebhook.Timestamp as a time.Time object
+// instead of a string.
+func (w *CallStatusWebhook) TimestampAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, w.Timestamp)
+}
+
+// https://www.twilio.com/docs/voice/api/recording#recordingstatuscallback
+// RecordingStatusWebhook is posted to the RecordingStatusCallback of a call,
+// conference or recording when the recording status changes.
+type RecordingStatusWebhook struct {
+	AccountSid         string          `form:"AccountSid"`
+	CallSid            string          `form:"CallSid"`
+	ConferenceSid      string          `form:"ConferenceSid"`
+	RecordingSid       string          `form:"RecordingSid"`
+	RecordingURL       string          `form:"RecordingUrl"`
+	RecordingStatus    RecordingStatus `form:"RecordingStatus"`
+	RecordingDuration  int             `form:"RecordingDuration"`
+	RecordingChannels  int             `form:"RecordingChannels"`
+	RecordingStartTime string          `form:"RecordingStartTime"`
+	RecordingSource    string          `form:"RecordingSource"`
+	RecordingTrack     string          `form:"RecordingTrack"`
+	ErrorCode          ExceptionCode   `form:"ErrorCode"`
+}
+
+// https://www.twilio.com/docs/voice/answering-machine-detection#asyncamdstatuscallback
+// AsyncAmdWebhook is posted to the AsyncAmdStatusCallback of a call once
+// answering machine detection has finished.
+type AsyncAmdWebhook struct {
+	AccountSid               string `form:"AccountSid"`
+	CallSid                  string `form:"CallSid"`
+	AnsweredBy               string `form:"AnsweredBy"`
+	MachineDetectionDuration int    `form:"MachineDetectionDuration"`
+}
+
+// ConferenceEvent is the StatusCallbackEvent of a ConferenceStatusWebhook.
+// See https://www.twilio.com/docs/voice/api/conference-resource#conference-status-callback-events
+type ConferenceEvent string
+
+const (
+	ConferenceEventStart               ConferenceEvent = "conference-start"
+	ConferenceEventEnd                 ConferenceEvent = "conference-end"
+	ConferenceEventParticipantJoin     ConferenceEvent = "participant-join"
+	ConferenceEventParticipantLeave    ConferenceEvent = "participant-leave"
+	ConferenceEventParticipantMute     ConferenceEvent = "participant-mute"
+	ConferenceEventParticipantUnmute   ConferenceEvent = "participant-unmute"
+	ConferenceEventParticipantHold     ConferenceEvent = "participant-hold"
+	ConferenceEventParticipantUnhold   ConferenceEvent = "participant-unhold"
+	ConferenceEventParticipantModify   ConferenceEvent = "participant-modify"
+	ConferenceEventSpeechStart         ConferenceEvent = "participant-speech-start"
+	ConferenceEventSpeechStop          ConferenceEvent = "participant-speech-stop"
+	ConferenceEventAnnouncementEnd     ConferenceEvent = "announcement-end"
+	ConferenceEventAnnouncementFail    ConferenceEvent = "announcement-fail"
+	ConferenceEventRecordingInProgress ConferenceEvent = "recording-in-progress"
+	ConferenceEventRecordingCompleted  ConferenceEvent = "recording-completed"
+)
+
+// https://www.twilio.com/docs/voice/api/conference-resource#conference-status-callback
+// ConferenceStatusWebhook is posted to the ConferenceStatusCallback of a
+// conference for each of the ConferenceStatusCallbackEvent values it
+// subscribed to. The participant fields are only set for participant events.
+type ConferenceStatusWebhook struct {
+	AccountSid          string          `form:"AccountSid"`
+	ConferenceSid       string          `form:"ConferenceSid"`
+	FriendlyName        string          `form:"FriendlyName"`
+	StatusCallbackEvent ConferenceEvent `form:"StatusCallbackEvent"`
+	SequenceNumber      int             `form:"SequenceNumber"`
+	Timestamp           string          `form:"Timestamp"`
+
+	CallSid                string `form:"CallSid"`
+	ParticipantLabel       string `form:"ParticipantLabel"`
+	Muted                  bool   `form:"Muted"`
+	Hold                   bool   `form:"Hold"`
+	Coaching               bool   `form:"Coaching"`
+	CallSidToCoach         string `form:"CallSidToCoach"`
+	StartConferenceOnEnter bool   `form:"StartConferenceOnEnter"`
+	EndConferenceOnExit    bool   `form:"EndConferenceOnExit"`
+
+	ReasonConferenceEnded            string `form:"ReasonConferenceEnded"`
+	CallSidEndingConference          string `form:"CallSidEndingConference"`
+	ParticipantLabelEndingConference string `form:"ParticipantLabelEndingConference"`
+	Reason                           string `form:"Reason"`
+
+	RecordingSid string `form:"RecordingSid"`
+	RecordingURL string `form:"RecordingUrl"`
+}
+
+// TimestampAsTime returns ConferenceStatusWebhook.Timestamp as a time.Time
+// object instead of a string.
+func (w *ConferenceStatusWebhook) TimestampAsTime() (time.Time, error) {
+	return time.Parse(time.RFC1123Z, w.Timestamp)
+}
+
+// FaxStatus is the status of a fax.
+// See https://www.twilio.com/docs/fax/api/fax-resource#fax-status-values
+type FaxStatus string
+
+const (
+	FaxStatusQueued     FaxStatus = "queued"
+	FaxStatusProcessing FaxStatus = "processing"
+	FaxStatusSending    FaxStatus = "sending"
+	FaxStatusDelivered  FaxStatus = "delivered"
+	FaxStatusReceiving  FaxStatus = "receiving"
+	FaxStatusReceived   FaxStatus = "received"
+	FaxStatusNoAnswer   FaxStatus = "no-answer"
+	FaxStatusBusy       FaxStatus = "busy"
+	FaxStatusFailed     FaxStatus = "failed"
+	FaxStatusCanceled   FaxStatus = "canceled"
+)
+
+// IsTerminal reports whether the fax won't change status anymore.
+func (s FaxStatus) IsTerminal() bool {
+	switch s {
+	case FaxStatusDelivered, FaxStatusReceived, FaxStatusNoAnswer, FaxStatusBusy,
+		FaxStatusFailed, FaxStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// https://www.twilio.com/docs/fax/receive#receiving-a-fax
+// IncomingFaxWebhook is posted to the fax URL of a phone number or SIP
+// domain when a fax arrives, the FaxResponse decides whether to receive it.
+type IncomingFaxWebhook struct {
+	FaxSid          string    `form:"FaxSid"`
+	AccountSid      string    `form:"AccountSid"`
+	From            string    `form:"From"`
+	To              string    `form:"To"`
+	RemoteStationID string    `form:"RemoteStationId"`
+	FaxStatus       FaxStatus `form:"FaxStatus"`
+	APIVersion      string    `form:"ApiVersion"`
+}
+
+// https://www.twilio.com/docs/fax/api/fax-resource#statuscallback
+// FaxStatusWebhook is posted to the StatusCallback of a sent fax, or to the
+// action of a received fax, once the fax has finished.
+type FaxStatusWebhook struct {
+	IncomingFaxWebhook
+
+	OriginalMediaURL string        `form:"OriginalMediaUrl"`
+	MediaURL         string        `form:"MediaUrl"`
+	NumPages         int           `form:"NumPages"`
+	ErrorCode        ExceptionCode `form:"ErrorCode"`
+	ErrorMessage     string        `form:"ErrorMessage"`
+}
+
+// IsTerminal reports whether this is the final status callback of the fax.
+func (w *FaxStatusWebhook) IsTerminal() bool {
+	return w.FaxStatus.IsTerminal()
+}
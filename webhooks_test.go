@@ -0,0 +1,80 @@
+package gotwilio
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDecodeMessageStatusWebhook(t *testing.T) {
+	form := url.Values{
+		"AccountSid":     {"AC1"},
+		"ApiVersion":     {"2010-04-01"},
+		"MessageSid":     {"SM1"},
+		"SmsSid":         {"SM1"},
+		"MessageStatus":  {"undelivered"},
+		"SmsStatus":      {"undelivered"},
+		"To":             {"+19135551234"},
+		"From":           {"+15005550006"},
+		"ErrorCode":      {"30003"},
+		"RawDlrDoneDate": {"2001021504"},
+	}
+
+	var hook MessageStatusWebhook
+	if err := DecodeWebhook(form, &hook); err != nil {
+		t.Fatal(err)
+	}
+
+	if hook.MessageStatus != MessageStatusUndelivered || !hook.IsTerminal() {
+		t.Errorf("Expected terminal undelivered status, got %s", hook.MessageStatus)
+	}
+	if hook.ErrorCode != 30003 {
+		t.Errorf("Expected error code 30003, got %d", hook.ErrorCode)
+	}
+
+	done, err := hook.RawDlrDoneDateAsTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !done.Equal(time.Date(2020, 1, 2, 15, 4, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected DLR done date: %v", done)
+	}
+
+	if MessageStatusSent.IsTerminal() {
+		t.Error("Expected sent not to be terminal")
+	}
+}
+
+func TestDecodeCallStatusWebhook(t *testing.T) {
+	form := url.Values{
+		"AccountSid":      {"AC1"},
+		"CallSid":         {"CA1"},
+		"CallStatus":      {"completed"},
+		"From":            {"+15005550006"},
+		"To":              {"+19135551234"},
+		"Direction":       {"outbound-api"},
+		"CallDuration":    {"42"},
+		"SequenceNumber":  {"3"},
+		"Timestamp":       {"Tue, 14 Jan 2020 15:04:05 +0000"},
+		"CallbackSource":  {"call-progress-events"},
+		"SipResponseCode": {"200"},
+	}
+
+	var hook CallStatusWebhook
+	if err := DecodeWebhook(form, &hook); err != nil {
+		t.Fatal(err)
+	}
+
+	if hook.CallSid != "CA1" || hook.CallStatus != CallStatusCompleted || !hook.IsTerminal() {
+		t.Errorf("Unexpected call status: %+v", hook)
+	}
+	if hook.CallDuration != 42 || hook.SequenceNumber != 3 || hook.SipResponseCode != 200 {
+		t.Errorf("Unexpected numeric fields: %+v", hook)
+	}
+	if _, err := hook.TimestampAsTime(); err != nil {
+		t.Error(err)
+	}
+	if CallStatusRinging.IsTerminal() {
+		t.Error("Expected ringing not to be terminal")
+	}
+}